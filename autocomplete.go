@@ -3,7 +3,6 @@ package geoapify
 import (
 	"context"
 	"net/url"
-	"strings"
 )
 
 // AutocompleteRequest is a builder for address autocomplete requests.
@@ -12,8 +11,8 @@ type AutocompleteRequest struct {
 	text    string
 	locType LocationType
 	lang    string
-	filters []string
-	biases  []string
+	filters []Filter
+	biases  []Bias
 	format  Format
 }
 
@@ -38,13 +37,13 @@ func (r *AutocompleteRequest) WithLang(v string) *AutocompleteRequest {
 }
 
 // WithFilter adds geocoding filters (joined with |).
-func (r *AutocompleteRequest) WithFilter(filters ...string) *AutocompleteRequest {
+func (r *AutocompleteRequest) WithFilter(filters ...Filter) *AutocompleteRequest {
 	r.filters = append(r.filters, filters...)
 	return r
 }
 
 // WithBias adds geocoding biases (joined with |).
-func (r *AutocompleteRequest) WithBias(biases ...string) *AutocompleteRequest {
+func (r *AutocompleteRequest) WithBias(biases ...Bias) *AutocompleteRequest {
 	r.biases = append(r.biases, biases...)
 	return r
 }
@@ -66,11 +65,8 @@ func (r *AutocompleteRequest) Do(ctx context.Context) (*GeocodingResponse, error
 	if r.lang != "" {
 		params.Set("lang", r.lang)
 	}
-	if len(r.filters) > 0 {
-		params.Set("filter", strings.Join(r.filters, "|"))
-	}
-	if len(r.biases) > 0 {
-		params.Set("bias", strings.Join(r.biases, "|"))
+	if err := setFilterParams(params, r.filters, r.biases); err != nil {
+		return nil, err
 	}
 	if r.format != "" {
 		params.Set("format", string(r.format))
@@ -30,7 +30,7 @@ func TestAutocomplete_AllBuilderOptions(t *testing.T) {
 		assertEqual(t, q.Get("lang"), "fr")
 		assertEqual(t, q.Get("format"), "json")
 		assertEqual(t, q.Get("filter"), "countrycode:de")
-		assertEqual(t, q.Get("bias"), "proximity:13.000000,52.000000")
+		assertEqual(t, q.Get("bias"), "proximity:13,52")
 		w.Write(mustJSON(t, GeocodingResponse{Results: []Address{{City: "Berlin"}}}))
 	})
 
@@ -50,8 +50,8 @@ func TestAutocomplete_AllBuilderOptions(t *testing.T) {
 func TestAutocomplete_FilterAndBias(t *testing.T) {
 	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
 		q := r.URL.Query()
-		assertEqual(t, q.Get("filter"), "countrycode:us|rect:-130.000000,20.000000,-60.000000,50.000000")
-		assertEqual(t, q.Get("bias"), "countrycode:us|proximity:-122.000000,47.000000")
+		assertEqual(t, q.Get("filter"), "countrycode:us|rect:-130,20,-60,50")
+		assertEqual(t, q.Get("bias"), "countrycode:us|proximity:-122,47")
 		w.Write(mustJSON(t, GeocodingResponse{Results: []Address{}}))
 	})
 
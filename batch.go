@@ -0,0 +1,254 @@
+package geoapify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// BatchService provides access to the GeoApify generic Batch API, which runs
+// many requests as one asynchronous job at a reduced credit rate.
+type BatchService struct {
+	client *Client
+}
+
+// BatchItem is a request that can run as part of a batch job. It is
+// implemented by SearchRequest, ReverseGeocodingRequest, RoutingRequest,
+// IsolineRequest, PlacesRequest and PlaceDetailsRequest.
+type BatchItem interface {
+	batchAPI() string
+	params() (url.Values, error)
+	decodeBatchResult(data []byte) (any, error)
+}
+
+// Submit creates a batch request for the given items. Items may target
+// different APIs; the Batch API accepts one API per job, so one job is
+// submitted per API and all of them are polled together.
+func (s *BatchService) Submit(items ...BatchItem) *BatchRequest {
+	return &BatchRequest{service: s, items: items}
+}
+
+// BatchRequest is a builder for generic batch jobs.
+type BatchRequest struct {
+	service *BatchService
+	items   []BatchItem
+	poll    pollConfig
+}
+
+// WithPollInterval sets how often the jobs are polled. The delay starts at
+// interval and doubles up to maxInterval.
+func (r *BatchRequest) WithPollInterval(interval, maxInterval time.Duration) *BatchRequest {
+	r.poll = pollConfig{interval: interval, maxInterval: maxInterval}
+	return r
+}
+
+// BatchResult is the outcome of one batch item. Value holds the typed
+// response of the item's Do method, e.g. *RoutingResponse for a
+// RoutingRequest, and is nil when Err is set.
+type BatchResult struct {
+	Value any
+	Err   error
+}
+
+// BatchValue returns the typed value of a batch result.
+//
+//	route, err := geoapify.BatchValue[*geoapify.RoutingResponse](results[0])
+func BatchValue[T any](r BatchResult) (T, error) {
+	var zero T
+	if r.Err != nil {
+		return zero, r.Err
+	}
+	v, ok := r.Value.(T)
+	if !ok {
+		return zero, fmt.Errorf("geoapify: batch result is %T, not %T", r.Value, zero)
+	}
+	return v, nil
+}
+
+type batchJob struct {
+	API    string          `json:"api"`
+	Inputs []batchJobInput `json:"inputs"`
+}
+
+type batchJobInput struct {
+	ID     string            `json:"id"`
+	Params map[string]string `json:"params"`
+}
+
+type batchJobStatus struct {
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	Results []struct {
+		ID     string          `json:"id"`
+		Result json.RawMessage `json:"result"`
+	} `json:"results"`
+}
+
+// Do submits the jobs, waits for them to finish and returns one result per
+// item in input order. Items that fail validation, belong to a job that
+// could not be submitted or fail on the server get a per-item error; the
+// returned error is reserved for failures of the request as a whole.
+func (r *BatchRequest) Do(ctx context.Context) ([]BatchResult, error) {
+	if len(r.items) == 0 {
+		return nil, fmt.Errorf("%w: batch requires at least one item", ErrInvalidArgument)
+	}
+
+	results := make([]BatchResult, len(r.items))
+	jobs := map[string]*batchJob{}
+	var apis []string
+	for i, item := range r.items {
+		params, err := item.params()
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		api := item.batchAPI()
+		job, ok := jobs[api]
+		if !ok {
+			job = &batchJob{API: api}
+			jobs[api] = job
+			apis = append(apis, api)
+		}
+		input := batchJobInput{ID: strconv.Itoa(i), Params: map[string]string{}}
+		for k := range params {
+			input.Params[k] = params.Get(k)
+		}
+		job.Inputs = append(job.Inputs, input)
+	}
+
+	// A job that fails to submit fails its own items only, so that the jobs
+	// already submitted, and paid for, are still collected.
+	pending := map[string]*batchJob{}
+	var submitErr error
+	for _, api := range apis {
+		var resp BatchJobResponse
+		if err := r.service.client.doPost(ctx, "/v1/batch", nil, jobs[api], &resp); err != nil {
+			submitErr = fmt.Errorf("submitting %s batch: %w", api, err)
+			for _, input := range jobs[api].Inputs {
+				i, _ := strconv.Atoi(input.ID)
+				results[i].Err = submitErr
+			}
+			continue
+		}
+		pending[resp.ID] = jobs[api]
+	}
+	if len(pending) == 0 && submitErr != nil {
+		return nil, submitErr
+	}
+
+	err := r.poll.do(ctx, func() (bool, error) {
+		for id, job := range pending {
+			var status batchJobStatus
+			if err := r.service.client.doGet(ctx, "/v1/batch", url.Values{"id": {id}}, &status); err != nil {
+				return false, err
+			}
+			switch status.Status {
+			case "pending", "running":
+				continue
+			case "", "finished":
+			default:
+				return false, fmt.Errorf("batch job %s %s", id, status.Status)
+			}
+			for _, res := range status.Results {
+				i, err := strconv.Atoi(res.ID)
+				if err != nil || i < 0 || i >= len(r.items) {
+					continue
+				}
+				results[i] = decodeBatchItem(r.items[i], res.Result)
+			}
+			for _, input := range job.Inputs {
+				i, _ := strconv.Atoi(input.ID)
+				if results[i].Value == nil && results[i].Err == nil {
+					results[i].Err = errors.New("geoapify: no result for batch item")
+				}
+			}
+			delete(pending, id)
+		}
+		return len(pending) == 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// decodeBatchItem turns one raw batch result into a typed value or, when the
+// API reported a failure for the item, an APIError.
+func decodeBatchItem(item BatchItem, data json.RawMessage) BatchResult {
+	var status struct {
+		StatusCode int `json:"statusCode"`
+	}
+	if json.Unmarshal(data, &status) == nil && status.StatusCode >= 400 {
+		return BatchResult{Err: newAPIError(status.StatusCode, data)}
+	}
+	v, err := item.decodeBatchResult(data)
+	if err != nil {
+		return BatchResult{Err: fmt.Errorf("decoding response: %w", err)}
+	}
+	return BatchResult{Value: v}
+}
+
+func (r *SearchRequest) batchAPI() string { return "/v1/geocode/search" }
+
+func (r *SearchRequest) decodeBatchResult(data []byte) (any, error) {
+	var resp GeocodingResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (r *ReverseGeocodingRequest) batchAPI() string { return "/v1/geocode/reverse" }
+
+func (r *ReverseGeocodingRequest) decodeBatchResult(data []byte) (any, error) {
+	var resp GeocodingResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (r *RoutingRequest) batchAPI() string { return "/v1/routing" }
+
+func (r *RoutingRequest) decodeBatchResult(data []byte) (any, error) {
+	var resp RoutingResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	r.restoreWaypoints(&resp)
+	return &resp, nil
+}
+
+func (r *IsolineRequest) batchAPI() string { return "/v1/isoline" }
+
+func (r *IsolineRequest) decodeBatchResult(data []byte) (any, error) {
+	var resp IsolineResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (r *PlacesRequest) batchAPI() string { return "/v2/places" }
+
+func (r *PlacesRequest) decodeBatchResult(data []byte) (any, error) {
+	var resp PlacesResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (r *PlaceDetailsRequest) batchAPI() string { return "/v2/place-details" }
+
+func (r *PlaceDetailsRequest) decodeBatchResult(data []byte) (any, error) {
+	var resp GeoJSONFeatureCollection
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
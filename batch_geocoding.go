@@ -3,8 +3,10 @@ package geoapify
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // BatchGeocodingService provides access to the Batch Geocoding API.
@@ -26,7 +28,8 @@ type BatchResultResponse struct {
 	Status string `json:"status,omitempty"`
 	// When complete - results is an array of Address objects
 	Results []Address `json:"-"`
-	// Raw holds the raw JSON for flexible parsing
+	// Raw holds the raw JSON for flexible parsing. CSV results are converted
+	// to the equivalent JSON array.
 	Raw json.RawMessage `json:"-"`
 }
 
@@ -67,12 +70,14 @@ func bytes_trimLeft(data []byte) []byte {
 
 // BatchForwardRequest is a builder for submitting a forward batch geocoding job.
 type BatchForwardRequest struct {
-	client    *Client
-	addresses []string
-	locType   LocationType
-	lang      string
-	filters   []string
-	biases    []string
+	client     *Client
+	addresses  []string
+	structured []StructuredAddress
+	locType    LocationType
+	lang       string
+	filters    []Filter
+	biases     []Bias
+	wait       batchWaitConfig
 }
 
 // SubmitForward creates a builder for submitting a forward batch geocoding job.
@@ -83,6 +88,16 @@ func (s *BatchGeocodingService) SubmitForward(addresses []string) *BatchForwardR
 	}
 }
 
+// SubmitForwardStructured creates a builder for submitting a forward batch
+// geocoding job with structured addresses, which match more accurately than
+// free text.
+func (s *BatchGeocodingService) SubmitForwardStructured(addresses []StructuredAddress) *BatchForwardRequest {
+	return &BatchForwardRequest{
+		client:     s.client,
+		structured: addresses,
+	}
+}
+
 // WithType sets the location type filter.
 func (r *BatchForwardRequest) WithType(t LocationType) *BatchForwardRequest {
 	r.locType = t
@@ -96,19 +111,18 @@ func (r *BatchForwardRequest) WithLang(v string) *BatchForwardRequest {
 }
 
 // WithFilter adds geocoding filters (joined with |).
-func (r *BatchForwardRequest) WithFilter(filters ...string) *BatchForwardRequest {
+func (r *BatchForwardRequest) WithFilter(filters ...Filter) *BatchForwardRequest {
 	r.filters = append(r.filters, filters...)
 	return r
 }
 
 // WithBias adds geocoding biases (joined with |).
-func (r *BatchForwardRequest) WithBias(biases ...string) *BatchForwardRequest {
+func (r *BatchForwardRequest) WithBias(biases ...Bias) *BatchForwardRequest {
 	r.biases = append(r.biases, biases...)
 	return r
 }
 
-// Do executes the forward batch geocoding request.
-func (r *BatchForwardRequest) Do(ctx context.Context) (*BatchJobResponse, error) {
+func (r *BatchForwardRequest) params() (url.Values, error) {
 	params := url.Values{}
 	if r.locType != "" {
 		params.Set("type", string(r.locType))
@@ -116,26 +130,93 @@ func (r *BatchForwardRequest) Do(ctx context.Context) (*BatchJobResponse, error)
 	if r.lang != "" {
 		params.Set("lang", r.lang)
 	}
-	if len(r.filters) > 0 {
-		params.Set("filter", strings.Join(r.filters, "|"))
+	if err := setFilterParams(params, r.filters, r.biases); err != nil {
+		return nil, err
 	}
-	if len(r.biases) > 0 {
-		params.Set("bias", strings.Join(r.biases, "|"))
+	return params, nil
+}
+
+// Do executes the forward batch geocoding request.
+func (r *BatchForwardRequest) Do(ctx context.Context) (*BatchJobResponse, error) {
+	params, err := r.params()
+	if err != nil {
+		return nil, err
 	}
 
 	var resp BatchJobResponse
-	if err := r.client.doPost(ctx, "/v1/batch/geocode/search", params, r.addresses, &resp); err != nil {
+	if err := r.client.doPost(ctx, "/v1/batch/geocode/search", params, r.body(0, r.len()), &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
+func (r *BatchForwardRequest) len() int {
+	if r.structured != nil {
+		return len(r.structured)
+	}
+	return len(r.addresses)
+}
+
+// body returns the rows from start to end as the job's JSON body.
+func (r *BatchForwardRequest) body(start, end int) any {
+	if r.structured != nil {
+		return r.structured[start:end]
+	}
+	return r.addresses[start:end]
+}
+
+// queries returns the free-text form of every row, for row errors.
+func (r *BatchForwardRequest) queries() []string {
+	if r.structured == nil {
+		return r.addresses
+	}
+	out := make([]string, len(r.structured))
+	for i, a := range r.structured {
+		out[i] = a.String()
+	}
+	return out
+}
+
+// WithPollInterval sets how often SubmitAndWait polls the job. The delay
+// starts at interval and doubles up to maxInterval.
+func (r *BatchForwardRequest) WithPollInterval(interval, maxInterval time.Duration) *BatchForwardRequest {
+	r.wait.poll = pollConfig{interval: interval, maxInterval: maxInterval}
+	return r
+}
+
+// WithTimeout sets an overall deadline for SubmitAndWait.
+func (r *BatchForwardRequest) WithTimeout(d time.Duration) *BatchForwardRequest {
+	r.wait.timeout = d
+	return r
+}
+
+// WithProgress sets a callback invoked after each poll by SubmitAndWait.
+func (r *BatchForwardRequest) WithProgress(fn func(BatchProgress)) *BatchForwardRequest {
+	r.wait.progress = fn
+	return r
+}
+
+// SubmitAndWait submits the job and waits for its results. The returned
+// addresses are aligned with the input; see BatchResultRequest.Wait.
+func (r *BatchForwardRequest) SubmitAndWait(ctx context.Context) ([]Address, error) {
+	ctx, cancel := r.wait.context(ctx)
+	defer cancel()
+
+	job, err := r.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &BatchResultRequest{client: r.client, path: "/v1/batch/geocode/search", jobID: job.ID, wait: r.wait}
+	return result.waitRows(ctx, r.queries())
+}
+
 // BatchReverseRequest is a builder for submitting a reverse batch geocoding job.
 type BatchReverseRequest struct {
 	client      *Client
 	coordinates [][2]float64
 	locType     LocationType
 	lang        string
+	wait        batchWaitConfig
 }
 
 // SubmitReverse creates a builder for submitting a reverse batch geocoding job.
@@ -158,8 +239,7 @@ func (r *BatchReverseRequest) WithLang(v string) *BatchReverseRequest {
 	return r
 }
 
-// Do executes the reverse batch geocoding request.
-func (r *BatchReverseRequest) Do(ctx context.Context) (*BatchJobResponse, error) {
+func (r *BatchReverseRequest) params() (url.Values, error) {
 	params := url.Values{}
 	if r.locType != "" {
 		params.Set("type", string(r.locType))
@@ -167,6 +247,15 @@ func (r *BatchReverseRequest) Do(ctx context.Context) (*BatchJobResponse, error)
 	if r.lang != "" {
 		params.Set("lang", r.lang)
 	}
+	return params, nil
+}
+
+// Do executes the reverse batch geocoding request.
+func (r *BatchReverseRequest) Do(ctx context.Context) (*BatchJobResponse, error) {
+	params, err := r.params()
+	if err != nil {
+		return nil, err
+	}
 
 	var resp BatchJobResponse
 	if err := r.client.doPost(ctx, "/v1/batch/geocode/reverse", params, r.coordinates, &resp); err != nil {
@@ -175,12 +264,50 @@ func (r *BatchReverseRequest) Do(ctx context.Context) (*BatchJobResponse, error)
 	return &resp, nil
 }
 
+// WithPollInterval sets how often SubmitAndWait polls the job. The delay
+// starts at interval and doubles up to maxInterval.
+func (r *BatchReverseRequest) WithPollInterval(interval, maxInterval time.Duration) *BatchReverseRequest {
+	r.wait.poll = pollConfig{interval: interval, maxInterval: maxInterval}
+	return r
+}
+
+// WithTimeout sets an overall deadline for SubmitAndWait.
+func (r *BatchReverseRequest) WithTimeout(d time.Duration) *BatchReverseRequest {
+	r.wait.timeout = d
+	return r
+}
+
+// WithProgress sets a callback invoked after each poll by SubmitAndWait.
+func (r *BatchReverseRequest) WithProgress(fn func(BatchProgress)) *BatchReverseRequest {
+	r.wait.progress = fn
+	return r
+}
+
+// SubmitAndWait submits the job and waits for its results. The returned
+// addresses are aligned with the input; see BatchResultRequest.Wait.
+func (r *BatchReverseRequest) SubmitAndWait(ctx context.Context) ([]Address, error) {
+	ctx, cancel := r.wait.context(ctx)
+	defer cancel()
+
+	job, err := r.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]string, len(r.coordinates))
+	for i, c := range r.coordinates {
+		queries[i] = fmt.Sprintf("%g,%g", c[0], c[1])
+	}
+	result := &BatchResultRequest{client: r.client, path: "/v1/batch/geocode/reverse", jobID: job.ID, wait: r.wait}
+	return result.waitRows(ctx, queries)
+}
+
 // BatchResultRequest is a builder for polling batch geocoding results.
 type BatchResultRequest struct {
 	client *Client
 	path   string
 	jobID  string
 	format string
+	wait   batchWaitConfig
 }
 
 // GetForwardResult creates a builder to poll forward batch geocoding results.
@@ -201,7 +328,8 @@ func (s *BatchGeocodingService) GetReverseResult(jobID string) *BatchResultReque
 	}
 }
 
-// WithFormat sets the response format.
+// WithFormat sets the response format. CSV results are parsed into the same
+// typed Results as JSON ones.
 func (r *BatchResultRequest) WithFormat(v string) *BatchResultRequest {
 	r.format = v
 	return r
@@ -215,9 +343,198 @@ func (r *BatchResultRequest) Do(ctx context.Context) (*BatchResultResponse, erro
 		params.Set("format", r.format)
 	}
 
-	var resp BatchResultResponse
-	if err := r.client.doGet(ctx, r.path, params, &resp); err != nil {
+	var body []byte
+	if err := r.client.doGet(ctx, r.path, params, &body); err != nil {
 		return nil, err
 	}
+	// Pending jobs answer with a JSON status object whatever the format, so
+	// only convert bodies that are not JSON.
+	if trimmed := bytes_trimLeft(body); len(trimmed) > 0 && trimmed[0] != '[' && trimmed[0] != '{' {
+		converted, err := csvResultsToJSON(body)
+		if err != nil {
+			return nil, err
+		}
+		body = converted
+	}
+
+	var resp BatchResultResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
 	return &resp, nil
 }
+
+// WithPollInterval sets how often Wait polls the job. The delay starts at
+// interval and doubles up to maxInterval.
+func (r *BatchResultRequest) WithPollInterval(interval, maxInterval time.Duration) *BatchResultRequest {
+	r.wait.poll = pollConfig{interval: interval, maxInterval: maxInterval}
+	return r
+}
+
+// WithTimeout sets an overall deadline for Wait.
+func (r *BatchResultRequest) WithTimeout(d time.Duration) *BatchResultRequest {
+	r.wait.timeout = d
+	return r
+}
+
+// WithProgress sets a callback invoked after each poll by Wait.
+func (r *BatchResultRequest) WithProgress(fn func(BatchProgress)) *BatchResultRequest {
+	r.wait.progress = fn
+	return r
+}
+
+// Wait polls the job until it finishes and returns one address per result
+// row, in input order. Rows that could not be geocoded are left as zero
+// Addresses and reported together in a BatchRowErrors error, alongside the
+// other addresses.
+func (r *BatchResultRequest) Wait(ctx context.Context) ([]Address, error) {
+	ctx, cancel := r.wait.context(ctx)
+	defer cancel()
+	return r.waitRows(ctx, nil)
+}
+
+// BatchProgress reports the state of a batch job while it is being polled.
+type BatchProgress struct {
+	JobID   string
+	Status  string
+	Polls   int
+	Elapsed time.Duration
+}
+
+// BatchRowError describes an input row that failed to geocode.
+type BatchRowError struct {
+	Index   int
+	Query   string
+	Message string
+}
+
+func (e *BatchRowError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = "no result"
+	}
+	if e.Query != "" {
+		return fmt.Sprintf("geoapify: batch row %d (%q): %s", e.Index, e.Query, msg)
+	}
+	return fmt.Sprintf("geoapify: batch row %d: %s", e.Index, msg)
+}
+
+// BatchRowErrors is returned by the batch wait helpers when some rows failed.
+// Use errors.As to retrieve it.
+type BatchRowErrors []*BatchRowError
+
+func (e BatchRowErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	return fmt.Sprintf("geoapify: %d batch rows failed, first: %s", len(e), e[0].Error())
+}
+
+// batchWaitConfig holds the polling options shared by the batch builders.
+type batchWaitConfig struct {
+	poll     pollConfig
+	timeout  time.Duration
+	progress func(BatchProgress)
+}
+
+func (w batchWaitConfig) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if w.timeout > 0 {
+		return context.WithTimeout(ctx, w.timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// waitRows polls the job until it finishes and aligns the rows with queries.
+// When queries is nil the number of rows is taken from the response.
+func (r *BatchResultRequest) waitRows(ctx context.Context, queries []string) ([]Address, error) {
+	w := r.wait
+	start := time.Now()
+	polls := 0
+	var result *BatchResultResponse
+	err := w.poll.do(ctx, func() (bool, error) {
+		resp, err := r.Do(ctx)
+		if err != nil {
+			return false, err
+		}
+		polls++
+		status := resp.Status
+		if status == "" {
+			status = "finished"
+		}
+		if w.progress != nil {
+			w.progress(BatchProgress{JobID: r.jobID, Status: status, Polls: polls, Elapsed: time.Since(start)})
+		}
+		switch resp.Status {
+		case "pending", "running":
+			return false, nil
+		case "":
+			result = resp
+			return true, nil
+		default:
+			return false, fmt.Errorf("batch job %s %s", r.jobID, resp.Status)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return alignBatchRows(result.Raw, queries)
+}
+
+// alignBatchRows decodes the result rows and reports the rows that have no
+// geocoded location.
+func alignBatchRows(raw json.RawMessage, queries []string) ([]Address, error) {
+	var rows []json.RawMessage
+	if err := json.Unmarshal(raw, &rows); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+	n := len(rows)
+	if queries != nil {
+		n = len(queries)
+	}
+
+	addresses := make([]Address, n)
+	var rowErrs BatchRowErrors
+	for i := range addresses {
+		rowErr := &BatchRowError{Index: i}
+		if i < len(queries) {
+			rowErr.Query = queries[i]
+		}
+		if i >= len(rows) {
+			rowErrs = append(rowErrs, rowErr)
+			continue
+		}
+
+		var row struct {
+			Address
+			Query struct {
+				Text string `json:"text"`
+			} `json:"query"`
+			Error   json.RawMessage `json:"error"`
+			Message string          `json:"message"`
+		}
+		if err := json.Unmarshal(rows[i], &row); err != nil {
+			rowErr.Message = err.Error()
+			rowErrs = append(rowErrs, rowErr)
+			continue
+		}
+		if rowErr.Query == "" {
+			rowErr.Query = row.Query.Text
+		}
+		switch {
+		case len(row.Error) > 0 && string(row.Error) != "null":
+			rowErr.Message = row.Message
+			if rowErr.Message == "" {
+				rowErr.Message = strings.Trim(string(row.Error), `"`)
+			}
+			rowErrs = append(rowErrs, rowErr)
+		case row.Lat == 0 && row.Lon == 0 && row.Formatted == "":
+			rowErrs = append(rowErrs, rowErr)
+		default:
+			addresses[i] = row.Address
+		}
+	}
+	if len(rowErrs) > 0 {
+		return addresses, rowErrs
+	}
+	return addresses, nil
+}
@@ -3,9 +3,11 @@ package geoapify
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestBatchForward_Submit(t *testing.T) {
@@ -211,3 +213,145 @@ func TestBatchReverse_APIError(t *testing.T) {
 	_, err := client.BatchGeocoding().SubmitReverse([][2]float64{{0, 0}}).Do(context.Background())
 	assertError(t, err)
 }
+
+func TestBatchForward_SubmitAndWait(t *testing.T) {
+	polls := 0
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assertEqual(t, r.URL.Path, "/v1/batch/geocode/search")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"job-1","status":"pending"}`))
+			return
+		}
+		assertEqual(t, r.URL.Query().Get("id"), "job-1")
+		polls++
+		if polls < 3 {
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"job-1","status":"pending"}`))
+			return
+		}
+		w.Write([]byte(`[
+			{"query":{"text":"Berlin"},"city":"Berlin","lat":52.52,"lon":13.40,"formatted":"Berlin, Germany"},
+			{"query":{"text":"xyzzy"}},
+			{"query":{"text":"Paris"},"city":"Paris","lat":48.85,"lon":2.35,"formatted":"Paris, France"}
+		]`))
+	})
+
+	var progress []BatchProgress
+	addresses, err := client.BatchGeocoding().
+		SubmitForward([]string{"Berlin", "xyzzy", "Paris", "Rome"}).
+		WithPollInterval(time.Millisecond, 2*time.Millisecond).
+		WithProgress(func(p BatchProgress) { progress = append(progress, p) }).
+		SubmitAndWait(context.Background())
+
+	var rowErrs BatchRowErrors
+	if !errors.As(err, &rowErrs) {
+		t.Fatalf("expected BatchRowErrors, got %v", err)
+	}
+	assertEqual(t, len(rowErrs), 2)
+	assertEqual(t, rowErrs[0].Index, 1)
+	assertEqual(t, rowErrs[0].Query, "xyzzy")
+	assertEqual(t, rowErrs[1].Index, 3)
+	assertEqual(t, rowErrs[1].Query, "Rome")
+
+	assertEqual(t, len(addresses), 4)
+	assertEqual(t, addresses[0].City, "Berlin")
+	assertEqual(t, addresses[1].City, "")
+	assertEqual(t, addresses[2].City, "Paris")
+
+	assertEqual(t, len(progress), 3)
+	assertEqual(t, progress[0].Status, "pending")
+	assertEqual(t, progress[2].Status, "finished")
+	assertEqual(t, progress[2].Polls, 3)
+	assertEqual(t, progress[2].JobID, "job-1")
+}
+
+func TestBatchReverse_SubmitAndWait(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Write([]byte(`{"id":"job-2","status":"pending"}`))
+			return
+		}
+		w.Write([]byte(`[{"city":"Tacoma","lat":47.25,"lon":-122.44,"formatted":"Tacoma, WA"}]`))
+	})
+
+	addresses, err := client.BatchGeocoding().
+		SubmitReverse([][2]float64{{-122.44, 47.25}}).
+		SubmitAndWait(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, len(addresses), 1)
+	assertEqual(t, addresses[0].City, "Tacoma")
+}
+
+func TestBatchResult_Wait(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"query":{"text":"Berlin"},"city":"Berlin","lat":52.52,"lon":13.40},
+			{"query":{"text":"?"},"error":"not_found","message":"Address not found"}
+		]`))
+	})
+
+	addresses, err := client.BatchGeocoding().GetForwardResult("job-1").Wait(context.Background())
+	var rowErrs BatchRowErrors
+	if !errors.As(err, &rowErrs) {
+		t.Fatalf("expected BatchRowErrors, got %v", err)
+	}
+	assertEqual(t, len(rowErrs), 1)
+	assertEqual(t, rowErrs[0].Query, "?")
+	assertEqual(t, rowErrs[0].Message, "Address not found")
+	assertEqual(t, len(addresses), 2)
+	assertEqual(t, addresses[0].City, "Berlin")
+}
+
+func TestBatchResult_WaitTimeout(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"id":"job-1","status":"pending"}`))
+	})
+
+	_, err := client.BatchGeocoding().GetForwardResult("job-1").
+		WithPollInterval(time.Millisecond, time.Millisecond).
+		WithTimeout(20 * time.Millisecond).
+		Wait(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestBatchResult_WaitJobFailed(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"job-1","status":"failed"}`))
+	})
+
+	_, err := client.BatchGeocoding().GetForwardResult("job-1").Wait(context.Background())
+	assertError(t, err)
+}
+
+func TestBatchForward_Structured(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assertEqual(t, r.URL.Path, "/v1/batch/geocode/search")
+		if r.Method == http.MethodPost {
+			body, err := io.ReadAll(r.Body)
+			assertNoError(t, err)
+			assertEqual(t, string(body), `[{"housenumber":"1","street":"Main St","postcode":"98402","city":"Tacoma","country":"US"},{"city":"Nowhere"}]`)
+			w.Write([]byte(`{"id":"job-1","status":"pending"}`))
+			return
+		}
+		w.Write([]byte(`[{"city":"Tacoma","lat":47.25,"lon":-122.44}]`))
+	})
+
+	addresses, err := client.BatchGeocoding().
+		SubmitForwardStructured([]StructuredAddress{
+			{HouseNumber: "1", Street: "Main St", Postcode: "98402", City: "Tacoma", Country: "US"},
+			{City: "Nowhere"},
+		}).
+		SubmitAndWait(context.Background())
+
+	var rowErrs BatchRowErrors
+	if !errors.As(err, &rowErrs) {
+		t.Fatalf("expected BatchRowErrors, got %v", err)
+	}
+	assertEqual(t, rowErrs[0].Index, 1)
+	assertEqual(t, rowErrs[0].Query, "Nowhere")
+	assertEqual(t, addresses[0].City, "Tacoma")
+}
@@ -0,0 +1,432 @@
+package geoapify
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ColumnMapping maps input columns to address fields. When Text is set its
+// column is used as the free-text query; otherwise the query is built from
+// the structured fields.
+type ColumnMapping struct {
+	Text        string
+	Name        string
+	HouseNumber string
+	Street      string
+	Postcode    string
+	City        string
+	State       string
+	Country     string
+}
+
+// BatchInput is one row read from a CSV or JSONL file.
+type BatchInput struct {
+	// Columns lists the column names in input order.
+	Columns []string
+	// Values holds the original values by column name.
+	Values  map[string]string
+	Text    string
+	Address StructuredAddress
+}
+
+// Query returns the free-text query of the row: the mapped text column when
+// there is one, the joined structured fields otherwise.
+func (in BatchInput) Query() string {
+	if in.Text != "" {
+		return in.Text
+	}
+	return in.Address.String()
+}
+
+func (m ColumnMapping) apply(columns []string, values map[string]string) BatchInput {
+	in := BatchInput{Columns: columns, Values: values}
+	get := func(col string) string {
+		if col == "" {
+			return ""
+		}
+		return strings.TrimSpace(values[col])
+	}
+	in.Text = get(m.Text)
+	in.Address = StructuredAddress{
+		Name:        get(m.Name),
+		HouseNumber: get(m.HouseNumber),
+		Street:      get(m.Street),
+		Postcode:    get(m.Postcode),
+		City:        get(m.City),
+		State:       get(m.State),
+		Country:     get(m.Country),
+	}
+	return in
+}
+
+func (m ColumnMapping) validate(columns []string) error {
+	have := make(map[string]bool, len(columns))
+	for _, c := range columns {
+		have[c] = true
+	}
+	mapped := false
+	for _, c := range []string{m.Text, m.Name, m.HouseNumber, m.Street, m.Postcode, m.City, m.State, m.Country} {
+		if c == "" {
+			continue
+		}
+		if !have[c] {
+			return fmt.Errorf("%w: column %q not found", ErrInvalidArgument, c)
+		}
+		mapped = true
+	}
+	if !mapped {
+		return fmt.Errorf("%w: column mapping is empty", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// ReadCSV reads address rows from CSV with a header row.
+func ReadCSV(r io.Reader, m ColumnMapping) ([]BatchInput, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading csv header: %w", err)
+	}
+	if err := m.validate(header); err != nil {
+		return nil, err
+	}
+
+	var inputs []BatchInput
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return inputs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading csv: %w", err)
+		}
+		values := make(map[string]string, len(header))
+		for i, col := range header {
+			values[col] = record[i]
+		}
+		inputs = append(inputs, m.apply(header, values))
+	}
+}
+
+// ReadJSONL reads address rows from JSON Lines, one object per line. Column
+// order follows the keys of the first object; non-string values are kept in
+// their JSON form.
+func ReadJSONL(r io.Reader, m ColumnMapping) ([]BatchInput, error) {
+	var (
+		inputs  []BatchInput
+		columns []string
+	)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		keys, values, err := decodeJSONLine(data)
+		if err != nil {
+			return nil, fmt.Errorf("reading jsonl line %d: %w", line, err)
+		}
+		if columns == nil {
+			if err := m.validate(keys); err != nil {
+				return nil, err
+			}
+			columns = keys
+		}
+		inputs = append(inputs, m.apply(columns, values))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading jsonl: %w", err)
+	}
+	return inputs, nil
+}
+
+// decodeJSONLine decodes a flat JSON object, keeping its key order.
+func decodeJSONLine(data []byte) ([]string, map[string]string, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return nil, nil, err
+	}
+	keys := make([]string, 0, len(obj))
+	values := make(map[string]string, len(obj))
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key := tok.(string)
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, key)
+		var s string
+		switch {
+		case json.Unmarshal(raw, &s) == nil:
+			values[key] = s
+		case string(raw) == "null":
+			values[key] = ""
+		default:
+			values[key] = string(raw)
+		}
+	}
+	return keys, values, nil
+}
+
+// BatchQueries returns the free-text query of each input, for SubmitForward.
+func BatchQueries(inputs []BatchInput) []string {
+	out := make([]string, len(inputs))
+	for i, in := range inputs {
+		out[i] = in.Query()
+	}
+	return out
+}
+
+// BatchAddresses returns the structured address of each input, for
+// SubmitForwardStructured.
+func BatchAddresses(inputs []BatchInput) []StructuredAddress {
+	out := make([]StructuredAddress, len(inputs))
+	for i, in := range inputs {
+		out[i] = in.Address
+	}
+	return out
+}
+
+// batchResultColumns are appended to the original columns by the writers.
+var batchResultColumns = []string{"lat", "lon", "formatted", "confidence", "result_type"}
+
+func batchResultValues(a Address) []string {
+	if a.Lat == 0 && a.Lon == 0 && a.Formatted == "" {
+		return make([]string, len(batchResultColumns))
+	}
+	confidence := ""
+	if a.Rank != nil {
+		confidence = strconv.FormatFloat(a.Rank.Confidence, 'f', -1, 64)
+	}
+	return []string{
+		strconv.FormatFloat(a.Lat, 'f', -1, 64),
+		strconv.FormatFloat(a.Lon, 'f', -1, 64),
+		a.Formatted,
+		confidence,
+		a.ResultType,
+	}
+}
+
+// checkResultColumns rejects input columns that would clash with the result
+// columns appended by the writers.
+func checkResultColumns(columns []string) error {
+	for _, col := range columns {
+		if slices.Contains(batchResultColumns, col) {
+			return fmt.Errorf("%w: input column %q clashes with a result column; rename it before writing", ErrInvalidArgument, col)
+		}
+	}
+	return nil
+}
+
+// WriteCSV writes the inputs with their original columns followed by lat,
+// lon, formatted, confidence and result_type. results must be aligned with
+// inputs; zero Addresses produce empty result columns. An input column named
+// like a result column is an error.
+func WriteCSV(w io.Writer, inputs []BatchInput, results []Address) error {
+	if len(inputs) != len(results) {
+		return fmt.Errorf("%w: %d inputs but %d results", ErrInvalidArgument, len(inputs), len(results))
+	}
+	var columns []string
+	if len(inputs) > 0 {
+		columns = inputs[0].Columns
+	}
+	if err := checkResultColumns(columns); err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append(append([]string{}, columns...), batchResultColumns...)); err != nil {
+		return err
+	}
+	for i, in := range inputs {
+		record := make([]string, 0, len(columns)+len(batchResultColumns))
+		for _, col := range columns {
+			record = append(record, in.Values[col])
+		}
+		if err := cw.Write(append(record, batchResultValues(results[i])...)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSONL writes one JSON object per input with its original values, in
+// input column order, followed by lat, lon, formatted, confidence and
+// result_type. results must be aligned with inputs; result fields are
+// omitted for zero Addresses. An input column named like a result column is
+// an error.
+func WriteJSONL(w io.Writer, inputs []BatchInput, results []Address) error {
+	if len(inputs) != len(results) {
+		return fmt.Errorf("%w: %d inputs but %d results", ErrInvalidArgument, len(inputs), len(results))
+	}
+	bw := bufio.NewWriter(w)
+	for i, in := range inputs {
+		columns := inputColumns(in)
+		if err := checkResultColumns(columns); err != nil {
+			return err
+		}
+
+		var row jsonObjectWriter
+		for _, col := range columns {
+			row.add(col, in.Values[col])
+		}
+		a := results[i]
+		if a.Lat != 0 || a.Lon != 0 || a.Formatted != "" {
+			row.add("lat", a.Lat)
+			row.add("lon", a.Lon)
+			row.add("formatted", a.Formatted)
+			if a.Rank != nil {
+				row.add("confidence", a.Rank.Confidence)
+			}
+			row.add("result_type", a.ResultType)
+		}
+		data, err := row.bytes()
+		if err != nil {
+			return err
+		}
+		if _, err := bw.Write(data); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// inputColumns returns the columns of the input in order, followed by any
+// values the row has beyond them, sorted.
+func inputColumns(in BatchInput) []string {
+	columns := in.Columns
+	var extra []string
+	for k := range in.Values {
+		if !slices.Contains(in.Columns, k) {
+			extra = append(extra, k)
+		}
+	}
+	if len(extra) == 0 {
+		return columns
+	}
+	slices.Sort(extra)
+	return append(slices.Clone(columns), extra...)
+}
+
+// jsonObjectWriter builds a JSON object line with keys in insertion order.
+type jsonObjectWriter struct {
+	buf bytes.Buffer
+	err error
+}
+
+func (o *jsonObjectWriter) add(key string, value any) {
+	if o.err != nil {
+		return
+	}
+	k, err := json.Marshal(key)
+	if err != nil {
+		o.err = err
+		return
+	}
+	v, err := json.Marshal(value)
+	if err != nil {
+		o.err = err
+		return
+	}
+	if o.buf.Len() == 0 {
+		o.buf.WriteByte('{')
+	} else {
+		o.buf.WriteByte(',')
+	}
+	o.buf.Write(k)
+	o.buf.WriteByte(':')
+	o.buf.Write(v)
+}
+
+func (o *jsonObjectWriter) bytes() ([]byte, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	if o.buf.Len() == 0 {
+		o.buf.WriteByte('{')
+	}
+	o.buf.WriteString("}\n")
+	return o.buf.Bytes(), nil
+}
+
+// csvFloatColumns are the CSV result columns decoded as numbers.
+var csvFloatColumns = map[string]bool{
+	"lat": true, "lon": true, "distance": true,
+	"rank.importance": true, "rank.popularity": true, "rank.confidence": true,
+	"rank.confidence_city_level": true, "rank.confidence_street_level": true,
+	"rank.confidence_building_level": true,
+}
+
+// csvRankColumns are top-level CSV columns that belong to Address.Rank.
+var csvRankColumns = map[string]bool{
+	"confidence": true, "confidence_city_level": true, "confidence_street_level": true,
+	"confidence_building_level": true, "match_type": true, "importance": true, "popularity": true,
+}
+
+// csvResultsToJSON converts a CSV batch result into the JSON array the API
+// returns by default. Dotted column names become nested objects.
+func csvResultsToJSON(data []byte) (json.RawMessage, error) {
+	cr := csv.NewReader(bytes.NewReader(data))
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("decoding csv response: %w", err)
+	}
+	if len(records) == 0 {
+		return json.RawMessage("[]"), nil
+	}
+
+	header := records[0]
+	rows := make([]map[string]any, 0, len(records)-1)
+	for _, record := range records[1:] {
+		row := map[string]any{}
+		for i, col := range header {
+			if i >= len(record) || record[i] == "" {
+				continue
+			}
+			if csvRankColumns[col] {
+				col = "rank." + col
+			}
+			var value any = record[i]
+			if csvFloatColumns[col] {
+				f, err := strconv.ParseFloat(record[i], 64)
+				if err != nil {
+					return nil, fmt.Errorf("decoding csv column %s: %w", col, err)
+				}
+				value = f
+			}
+			setNested(row, strings.Split(col, "."), value)
+		}
+		rows = append(rows, row)
+	}
+	return json.Marshal(rows)
+}
+
+func setNested(m map[string]any, path []string, value any) {
+	for _, key := range path[:len(path)-1] {
+		child, ok := m[key].(map[string]any)
+		if !ok {
+			child = map[string]any{}
+			m[key] = child
+		}
+		m = child
+	}
+	m[path[len(path)-1]] = value
+}
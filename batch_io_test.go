@@ -0,0 +1,157 @@
+package geoapify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestReadCSV(t *testing.T) {
+	input := "id,street,city,zip,country\n" +
+		"1,Main St,Tacoma,98402,US\n" +
+		"2,,Berlin,,Germany\n"
+
+	rows, err := ReadCSV(strings.NewReader(input), ColumnMapping{
+		Street:   "street",
+		City:     "city",
+		Postcode: "zip",
+		Country:  "country",
+	})
+	assertNoError(t, err)
+	assertEqual(t, len(rows), 2)
+	assertEqual(t, rows[0].Address, StructuredAddress{Street: "Main St", City: "Tacoma", Postcode: "98402", Country: "US"})
+	assertEqual(t, rows[0].Values["id"], "1")
+	assertEqual(t, rows[0].Query(), "Main St, 98402 Tacoma, US")
+	assertEqual(t, rows[1].Query(), "Berlin, Germany")
+
+	queries := BatchQueries(rows)
+	assertEqual(t, queries[1], "Berlin, Germany")
+
+	addresses := BatchAddresses(rows)
+	assertEqual(t, addresses[1], StructuredAddress{City: "Berlin", Country: "Germany"})
+}
+
+func TestReadCSV_TextColumnAndErrors(t *testing.T) {
+	rows, err := ReadCSV(strings.NewReader("address\n\"1 Main St, Tacoma\"\n"), ColumnMapping{Text: "address"})
+	assertNoError(t, err)
+	assertEqual(t, rows[0].Query(), "1 Main St, Tacoma")
+
+	_, err = ReadCSV(strings.NewReader("address\nx\n"), ColumnMapping{Text: "missing"})
+	assertError(t, err)
+
+	_, err = ReadCSV(strings.NewReader("address\nx\n"), ColumnMapping{})
+	assertError(t, err)
+}
+
+func TestReadJSONL(t *testing.T) {
+	input := `{"id":7,"city":"Tacoma","street":"Main St","active":true}
+` + "\n" + `{"id":8,"city":"Berlin","street":null}
+`
+	rows, err := ReadJSONL(strings.NewReader(input), ColumnMapping{Street: "street", City: "city"})
+	assertNoError(t, err)
+	assertEqual(t, len(rows), 2)
+	assertEqual(t, strings.Join(rows[0].Columns, ","), "id,city,street,active")
+	assertEqual(t, rows[0].Values["id"], "7")
+	assertEqual(t, rows[0].Values["active"], "true")
+	assertEqual(t, rows[0].Query(), "Main St, Tacoma")
+	assertEqual(t, rows[1].Query(), "Berlin")
+
+	_, err = ReadJSONL(strings.NewReader("{bad"), ColumnMapping{City: "city"})
+	assertError(t, err)
+}
+
+func TestWriteCSV(t *testing.T) {
+	rows, err := ReadCSV(strings.NewReader("id,address\n1,Tacoma\n2,nowhere\n"), ColumnMapping{Text: "address"})
+	assertNoError(t, err)
+
+	var buf bytes.Buffer
+	err = WriteCSV(&buf, rows, []Address{
+		{Lat: 47.25, Lon: -122.44, Formatted: "Tacoma, WA", ResultType: "city", Rank: &Rank{Confidence: 0.9}},
+		{},
+	})
+	assertNoError(t, err)
+	assertEqual(t, buf.String(), "id,address,lat,lon,formatted,confidence,result_type\n"+
+		"1,Tacoma,47.25,-122.44,\"Tacoma, WA\",0.9,city\n"+
+		"2,nowhere,,,,,\n")
+
+	assertError(t, WriteCSV(&buf, rows, nil))
+}
+
+func TestWriteJSONL(t *testing.T) {
+	rows, err := ReadJSONL(strings.NewReader(`{"id":"1","address":"Tacoma"}`+"\n"+`{"id":"2","address":"nowhere"}`), ColumnMapping{Text: "address"})
+	assertNoError(t, err)
+
+	var buf bytes.Buffer
+	err = WriteJSONL(&buf, rows, []Address{
+		{Lat: 47.25, Lon: -122.44, Formatted: "Tacoma, WA", ResultType: "city", Rank: &Rank{Confidence: 0.9}},
+		{},
+	})
+	assertNoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assertEqual(t, len(lines), 2)
+	var first map[string]any
+	assertNoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	assertEqual(t, first["id"], "1")
+	assertEqual(t, first["lat"], 47.25)
+	assertEqual(t, first["confidence"], 0.9)
+	assertEqual(t, first["result_type"], "city")
+	assertEqual(t, lines[0], `{"id":"1","address":"Tacoma","lat":47.25,"lon":-122.44,"formatted":"Tacoma, WA","confidence":0.9,"result_type":"city"}`)
+	assertEqual(t, lines[1], `{"id":"2","address":"nowhere"}`)
+}
+
+func TestWriters_ResultColumnClash(t *testing.T) {
+	rows, err := ReadCSV(strings.NewReader("address,lat\nTacoma,1\n"), ColumnMapping{Text: "address"})
+	assertNoError(t, err)
+	results := []Address{{Lat: 47.25, Lon: -122.44}}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, rows, results); !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("WriteCSV: expected ErrInvalidArgument, got %v", err)
+	}
+	if err := WriteJSONL(&buf, rows, results); !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("WriteJSONL: expected ErrInvalidArgument, got %v", err)
+	}
+}
+
+func TestBatchResult_CSVFormat(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assertEqual(t, r.URL.Query().Get("format"), "csv")
+		w.Header().Set("Content-Type", "text/csv")
+		w.Write([]byte("query.text,lat,lon,formatted,city,result_type,confidence,match_type\n" +
+			"Tacoma,47.25,-122.44,\"Tacoma, WA\",Tacoma,city,0.9,full_match\n" +
+			"xyzzy,,,,,,,\n"))
+	})
+
+	resp, err := client.BatchGeocoding().GetForwardResult("job-1").WithFormat("csv").Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, len(resp.Results), 2)
+	assertEqual(t, resp.Results[0].City, "Tacoma")
+	assertEqual(t, resp.Results[0].Lat, 47.25)
+	assertEqual(t, resp.Results[0].Formatted, "Tacoma, WA")
+	assertEqual(t, resp.Results[0].Rank.Confidence, 0.9)
+	assertEqual(t, resp.Results[0].Rank.MatchType, "full_match")
+
+	addresses, err := client.BatchGeocoding().GetForwardResult("job-1").WithFormat("csv").Wait(context.Background())
+	var rowErrs BatchRowErrors
+	if !errors.As(err, &rowErrs) {
+		t.Fatalf("expected BatchRowErrors, got %v", err)
+	}
+	assertEqual(t, rowErrs[0].Query, "xyzzy")
+	assertEqual(t, addresses[0].City, "Tacoma")
+}
+
+func TestBatchResult_CSVPending(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"id":"job-1","status":"pending"}`))
+	})
+
+	resp, err := client.BatchGeocoding().GetForwardResult("job-1").WithFormat("csv").Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, resp.Status, "pending")
+}
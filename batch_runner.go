@@ -0,0 +1,388 @@
+package geoapify
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// maxBatchGeocodeSize is the largest number of rows the batch geocoding
+	// API accepts in a single job.
+	maxBatchGeocodeSize     = 1000
+	defaultBatchConcurrency = 4
+	defaultBatchAttempts    = 3
+)
+
+// BatchRunner geocodes inputs of any size by splitting them into API-sized
+// batch jobs, running them with bounded concurrency and reassembling the
+// results in input order. Failed jobs are resubmitted chunk by chunk.
+type BatchRunner struct {
+	client      *Client
+	path        string
+	params      func() (url.Values, error)
+	body        func(start, end int) any
+	queries     []string
+	chunkSize   int
+	concurrency int
+	attempts    int
+	wait        batchWaitConfig
+	progress    func(BatchRunProgress)
+	store       JobStore
+}
+
+// Runner returns a runner that submits the addresses in chunks with the
+// request's options.
+func (r *BatchForwardRequest) Runner() *BatchRunner {
+	return &BatchRunner{
+		client:  r.client,
+		path:    "/v1/batch/geocode/search",
+		params:  r.params,
+		body:    r.body,
+		queries: r.queries(),
+		wait:    batchWaitConfig{poll: r.wait.poll, timeout: r.wait.timeout},
+	}
+}
+
+// Runner returns a runner that submits the coordinates in chunks with the
+// request's options.
+func (r *BatchReverseRequest) Runner() *BatchRunner {
+	queries := make([]string, len(r.coordinates))
+	for i, c := range r.coordinates {
+		queries[i] = fmt.Sprintf("%g,%g", c[0], c[1])
+	}
+	return &BatchRunner{
+		client:  r.client,
+		path:    "/v1/batch/geocode/reverse",
+		params:  r.params,
+		body:    func(start, end int) any { return r.coordinates[start:end] },
+		queries: queries,
+		wait:    batchWaitConfig{poll: r.wait.poll, timeout: r.wait.timeout},
+	}
+}
+
+// WithChunkSize sets the number of rows per job. It defaults to, and may
+// not exceed, the API limit of 1000.
+func (r *BatchRunner) WithChunkSize(n int) *BatchRunner {
+	r.chunkSize = n
+	return r
+}
+
+// WithConcurrency sets how many jobs may be in flight at once.
+func (r *BatchRunner) WithConcurrency(n int) *BatchRunner {
+	r.concurrency = n
+	return r
+}
+
+// WithMaxAttempts sets how many times a chunk is submitted before it is
+// reported as failed. The default is 3.
+func (r *BatchRunner) WithMaxAttempts(n int) *BatchRunner {
+	r.attempts = n
+	return r
+}
+
+// WithPollInterval sets how often each job is polled. The delay starts at
+// interval and doubles up to maxInterval.
+func (r *BatchRunner) WithPollInterval(interval, maxInterval time.Duration) *BatchRunner {
+	r.wait.poll = pollConfig{interval: interval, maxInterval: maxInterval}
+	return r
+}
+
+// WithTimeout sets an overall deadline for the run.
+func (r *BatchRunner) WithTimeout(d time.Duration) *BatchRunner {
+	r.wait.timeout = d
+	return r
+}
+
+// WithProgress sets a callback invoked whenever a chunk finishes or is
+// resubmitted. It is called from a single goroutine at a time.
+func (r *BatchRunner) WithProgress(fn func(BatchRunProgress)) *BatchRunner {
+	r.progress = fn
+	return r
+}
+
+// WithJobStore records every submitted job in store. Running the same input
+// again with the same store collects the results of jobs that were already
+// submitted instead of submitting them again. The records of a run are
+// deleted once the results of all its chunks have been collected.
+func (r *BatchRunner) WithJobStore(store JobStore) *BatchRunner {
+	r.store = store
+	return r
+}
+
+// BatchRunProgress reports the progress of a BatchRunner.
+type BatchRunProgress struct {
+	Chunks    int
+	Completed int
+	Failed    int
+	// Resubmitted counts job submissions beyond the first for each chunk.
+	Resubmitted int
+}
+
+// BatchChunkError reports a chunk that failed on every attempt. Its rows are
+// left as zero Addresses.
+type BatchChunkError struct {
+	Start int
+	End   int
+	Err   error
+}
+
+func (e *BatchChunkError) Error() string {
+	return fmt.Sprintf("geoapify: batch rows %d-%d failed: %v", e.Start, e.End-1, e.Err)
+}
+
+func (e *BatchChunkError) Unwrap() error {
+	return e.Err
+}
+
+// batchChunk is a contiguous range of input rows submitted as one job.
+type batchChunk struct {
+	start int
+	end   int
+}
+
+func (r *BatchRunner) size() int {
+	if r.chunkSize <= 0 {
+		return maxBatchGeocodeSize
+	}
+	return r.chunkSize
+}
+
+func (r *BatchRunner) chunks() []batchChunk {
+	size := r.size()
+	var out []batchChunk
+	for start := 0; start < len(r.queries); start += size {
+		out = append(out, batchChunk{start: start, end: min(start+size, len(r.queries))})
+	}
+	return out
+}
+
+// Do runs every chunk and returns one address per input row, in order.
+// Rows that failed to geocode are reported in a BatchRowErrors and chunks
+// that failed on every attempt in BatchChunkErrors; both are joined into the
+// returned error and can be retrieved with errors.As.
+func (r *BatchRunner) Do(ctx context.Context) ([]Address, error) {
+	if r.chunkSize > maxBatchGeocodeSize {
+		return nil, fmt.Errorf("%w: chunk size %d exceeds %d", ErrInvalidArgument, r.chunkSize, maxBatchGeocodeSize)
+	}
+	params, err := r.params()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := r.wait.context(ctx)
+	defer cancel()
+
+	chunks := r.chunks()
+	addresses := make([]Address, len(r.queries))
+	var (
+		mu        sync.Mutex
+		rowErrs   BatchRowErrors
+		chunkErrs []error
+		progress  = BatchRunProgress{Chunks: len(chunks)}
+	)
+	report := func(update func(*BatchRunProgress)) {
+		mu.Lock()
+		defer mu.Unlock()
+		update(&progress)
+		if r.progress != nil {
+			r.progress(progress)
+		}
+	}
+
+	run := &batchRun{
+		params:   params,
+		attempts: r.attempts,
+		resubmitted: func() {
+			report(func(p *BatchRunProgress) { p.Resubmitted++ })
+		},
+	}
+	if run.attempts <= 0 {
+		run.attempts = defaultBatchAttempts
+	}
+	if r.store != nil {
+		if run.fingerprint, err = r.fingerprint(params); err != nil {
+			return nil, err
+		}
+		recs, err := r.store.Jobs(ctx, run.fingerprint)
+		if err != nil {
+			return nil, fmt.Errorf("loading batch jobs: %w", err)
+		}
+		run.resume = make(map[int]BatchJobRecord, len(recs))
+		for _, rec := range recs {
+			run.resume[rec.Start] = rec
+		}
+	}
+
+	concurrency := r.concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for _, c := range chunks {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			rows, err := r.runChunk(ctx, run, c)
+			var chunkRowErrs BatchRowErrors
+			if err != nil && !errors.As(err, &chunkRowErrs) {
+				mu.Lock()
+				chunkErrs = append(chunkErrs, &BatchChunkError{Start: c.start, End: c.end, Err: err})
+				mu.Unlock()
+				report(func(p *BatchRunProgress) { p.Failed++ })
+				return
+			}
+
+			mu.Lock()
+			copy(addresses[c.start:c.end], rows)
+			for _, e := range chunkRowErrs {
+				e.Index += c.start
+				rowErrs = append(rowErrs, e)
+			}
+			mu.Unlock()
+			report(func(p *BatchRunProgress) { p.Completed++ })
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	// Once every chunk's results are collected the records are no longer
+	// needed; keep them when a chunk failed so that a rerun can resume.
+	if r.store != nil && len(chunkErrs) == 0 {
+		if err := r.store.DeleteJobs(ctx, run.fingerprint); err != nil {
+			return addresses, fmt.Errorf("deleting batch jobs: %w", err)
+		}
+	}
+	if len(rowErrs) > 0 {
+		slices.SortFunc(rowErrs, func(a, b *BatchRowError) int { return a.Index - b.Index })
+		chunkErrs = append(chunkErrs, rowErrs)
+	}
+	if len(chunkErrs) > 0 {
+		return addresses, errors.Join(chunkErrs...)
+	}
+	return addresses, nil
+}
+
+// batchRun holds the state shared by the chunks of one BatchRunner.Do call.
+type batchRun struct {
+	params      url.Values
+	attempts    int
+	fingerprint string
+	resume      map[int]BatchJobRecord
+	resubmitted func()
+}
+
+// runChunk waits for the chunk's job, submitting it first unless the job
+// store holds a job for it from an earlier run. When the job itself fails
+// the chunk is resubmitted. Row-level failures are returned as
+// BatchRowErrors and are not retried.
+func (r *BatchRunner) runChunk(ctx context.Context, run *batchRun, c batchChunk) ([]Address, error) {
+	var jobID string
+	if rec, ok := run.resume[c.start]; ok && rec.End == c.end && rec.State != JobFailed {
+		jobID = rec.JobID
+	}
+
+	var lastErr error
+	for attempt := range run.attempts {
+		if jobID == "" {
+			if attempt > 0 {
+				run.resubmitted()
+			}
+			var job BatchJobResponse
+			// buildURL adds the API key to the values, so each goroutine
+			// needs its own copy.
+			if err := r.client.doPost(ctx, r.path, maps.Clone(run.params), r.body(c.start, c.end), &job); err != nil {
+				lastErr = err
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				continue
+			}
+			jobID = job.ID
+			if err := r.record(ctx, run, c, jobID, JobSubmitted); err != nil {
+				return nil, err
+			}
+		}
+
+		result := &BatchResultRequest{client: r.client, path: r.path, jobID: jobID, wait: r.wait}
+		rows, err := result.waitRows(ctx, r.queries[c.start:c.end])
+		var rowErrs BatchRowErrors
+		if err == nil || errors.As(err, &rowErrs) {
+			if err := r.record(ctx, run, c, jobID, JobFinished); err != nil {
+				return nil, err
+			}
+			return rows, err
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		lastErr = err
+		if err := r.record(ctx, run, c, jobID, JobFailed); err != nil {
+			return nil, err
+		}
+		jobID = ""
+	}
+	return nil, lastErr
+}
+
+// record saves the state of a chunk's job when a job store is configured.
+func (r *BatchRunner) record(ctx context.Context, run *batchRun, c batchChunk, jobID string, state BatchJobState) error {
+	if r.store == nil {
+		return nil
+	}
+	err := r.store.SaveJob(ctx, BatchJobRecord{
+		Fingerprint: run.fingerprint,
+		JobID:       jobID,
+		Path:        r.path,
+		Start:       c.start,
+		End:         c.end,
+		State:       state,
+		UpdatedAt:   time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("saving batch job %s: %w", jobID, err)
+	}
+	return nil
+}
+
+// Fingerprint identifies the run in a JobStore. It covers the API, its
+// parameters, the chunk size and the full input, so any change to them
+// starts a new run.
+func (r *BatchRunner) Fingerprint() (string, error) {
+	params, err := r.params()
+	if err != nil {
+		return "", err
+	}
+	return r.fingerprint(params)
+}
+
+func (r *BatchRunner) fingerprint(params url.Values) (string, error) {
+	input, err := json.Marshal(r.body(0, len(r.queries)))
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%d\n", r.path, params.Encode(), r.size())
+	h.Write(input)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
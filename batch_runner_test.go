@@ -0,0 +1,261 @@
+package geoapify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeBatchServer serves forward batch jobs that geocode every address
+// except "bad". Jobs whose first address is in failJobs fail that many times.
+func fakeBatchServer(t *testing.T, failJobs map[string]int) (*Client, *sync.Map) {
+	t.Helper()
+	var (
+		mu     sync.Mutex
+		jobs   = map[string][]string{}
+		nextID int
+	)
+	submits := &sync.Map{}
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method == http.MethodPost {
+			body, err := io.ReadAll(r.Body)
+			assertNoError(t, err)
+			var addresses []string
+			assertNoError(t, json.Unmarshal(body, &addresses))
+			n, _ := submits.LoadOrStore(addresses[0], 0)
+			submits.Store(addresses[0], n.(int)+1)
+			nextID++
+			id := fmt.Sprintf("job-%d", nextID)
+			jobs[id] = addresses
+			w.Write([]byte(`{"id":"` + id + `","status":"pending"}`))
+			return
+		}
+
+		addresses := jobs[r.URL.Query().Get("id")]
+		if failJobs[addresses[0]] > 0 {
+			failJobs[addresses[0]]--
+			w.Write([]byte(`{"status":"failed"}`))
+			return
+		}
+		rows := make([]string, len(addresses))
+		for i, a := range addresses {
+			if a == "bad" {
+				rows[i] = `{"query":{"text":"bad"}}`
+				continue
+			}
+			rows[i] = fmt.Sprintf(`{"query":{"text":%q},"city":%q,"lat":1,"lon":1}`, a, a)
+		}
+		w.Write([]byte("[" + strings.Join(rows, ",") + "]"))
+	})
+	return client, submits
+}
+
+func TestBatchRunner_ChunksInOrder(t *testing.T) {
+	client, submits := fakeBatchServer(t, map[string]int{"a3": 1})
+
+	input := make([]string, 10)
+	for i := range input {
+		input[i] = fmt.Sprintf("a%d", i)
+	}
+	input[7] = "bad"
+
+	var last BatchRunProgress
+	addresses, err := client.BatchGeocoding().SubmitForward(input).
+		WithLang("en").
+		Runner().
+		WithChunkSize(3).
+		WithConcurrency(2).
+		WithPollInterval(time.Millisecond, time.Millisecond).
+		WithProgress(func(p BatchRunProgress) { last = p }).
+		Do(context.Background())
+
+	var rowErrs BatchRowErrors
+	if !errors.As(err, &rowErrs) {
+		t.Fatalf("expected BatchRowErrors, got %v", err)
+	}
+	assertEqual(t, len(rowErrs), 1)
+	assertEqual(t, rowErrs[0].Index, 7)
+
+	assertEqual(t, len(addresses), 10)
+	for i, a := range addresses {
+		if i == 7 {
+			assertEqual(t, a.City, "")
+			continue
+		}
+		assertEqual(t, a.City, input[i])
+	}
+
+	assertEqual(t, last, BatchRunProgress{Chunks: 4, Completed: 4, Resubmitted: 1})
+	n, _ := submits.Load("a3")
+	assertEqual(t, n, 2)
+	n, _ = submits.Load("a0")
+	assertEqual(t, n, 1)
+}
+
+func TestBatchRunner_ChunkFailure(t *testing.T) {
+	client, submits := fakeBatchServer(t, map[string]int{"a2": 5})
+
+	store := NewMemoryJobStore()
+	runner := client.BatchGeocoding().
+		SubmitForward([]string{"a0", "a1", "a2", "a3"}).
+		Runner().
+		WithChunkSize(2).
+		WithMaxAttempts(2).
+		WithPollInterval(time.Millisecond, time.Millisecond).
+		WithJobStore(store)
+	addresses, err := runner.Do(context.Background())
+
+	var chunkErr *BatchChunkError
+	if !errors.As(err, &chunkErr) {
+		t.Fatalf("expected BatchChunkError, got %v", err)
+	}
+	assertEqual(t, chunkErr.Start, 2)
+	assertEqual(t, chunkErr.End, 4)
+	assertEqual(t, addresses[0].City, "a0")
+	assertEqual(t, addresses[2].City, "")
+
+	n, _ := submits.Load("a2")
+	assertEqual(t, n, 2)
+
+	// The records are kept for a rerun since a chunk failed.
+	fingerprint, err := runner.Fingerprint()
+	assertNoError(t, err)
+	recs, err := store.Jobs(context.Background(), fingerprint)
+	assertNoError(t, err)
+	assertEqual(t, len(recs), 2)
+}
+
+func TestBatchRunner_Reverse(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assertEqual(t, r.URL.Path, "/v1/batch/geocode/reverse")
+		if r.Method == http.MethodPost {
+			w.Write([]byte(`{"id":"job-1","status":"pending"}`))
+			return
+		}
+		w.Write([]byte(`[{"city":"Tacoma","lat":47.25,"lon":-122.44}]`))
+	})
+
+	addresses, err := client.BatchGeocoding().
+		SubmitReverse([][2]float64{{-122.44, 47.25}}).
+		Runner().
+		Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, addresses[0].City, "Tacoma")
+}
+
+func TestBatchRunner_Validation(t *testing.T) {
+	client := NewClient("test-api-key")
+
+	_, err := client.BatchGeocoding().SubmitForward([]string{"a"}).Runner().WithChunkSize(5000).Do(context.Background())
+	assertError(t, err)
+
+	_, err = client.BatchGeocoding().SubmitForward([]string{"a"}).WithFilter(CountryFilter("")).Runner().Do(context.Background())
+	assertError(t, err)
+}
+
+func TestBatchRunner_Resume(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		posts   int
+		release bool
+	)
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Method == http.MethodPost {
+			posts++
+			body, err := io.ReadAll(r.Body)
+			assertNoError(t, err)
+			var addresses []string
+			assertNoError(t, json.Unmarshal(body, &addresses))
+			w.Write([]byte(`{"id":"job-` + addresses[0] + `","status":"pending"}`))
+			return
+		}
+		if !release {
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"status":"pending"}`))
+			return
+		}
+		switch r.URL.Query().Get("id") {
+		case "job-a0":
+			w.Write([]byte(`[{"city":"a0","lat":1,"lon":1},{"city":"a1","lat":1,"lon":1}]`))
+		case "job-a2":
+			w.Write([]byte(`[{"city":"a2","lat":1,"lon":1}]`))
+		}
+	})
+
+	store := NewFileJobStore(filepath.Join(t.TempDir(), "jobs.json"))
+	runner := func() *BatchRunner {
+		return client.BatchGeocoding().
+			SubmitForward([]string{"a0", "a1", "a2"}).
+			Runner().
+			WithChunkSize(2).
+			WithPollInterval(time.Millisecond, time.Millisecond).
+			WithJobStore(store)
+	}
+
+	_, err := runner().WithTimeout(30 * time.Millisecond).Do(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	assertEqual(t, posts, 2)
+
+	fingerprint, err := runner().Fingerprint()
+	assertNoError(t, err)
+	recs, err := store.Jobs(context.Background(), fingerprint)
+	assertNoError(t, err)
+	assertEqual(t, len(recs), 2)
+	assertEqual(t, recs[1].Start, 2)
+	assertEqual(t, recs[1].State, JobSubmitted)
+
+	mu.Lock()
+	release = true
+	mu.Unlock()
+
+	addresses, err := runner().Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, posts, 2)
+	assertEqual(t, addresses[0].City, "a0")
+	assertEqual(t, addresses[2].City, "a2")
+
+	recs, err = store.Jobs(context.Background(), fingerprint)
+	assertNoError(t, err)
+	assertEqual(t, len(recs), 0)
+
+	other, err := client.BatchGeocoding().SubmitForward([]string{"a0", "a1", "a2"}).WithLang("de").Runner().WithChunkSize(2).Fingerprint()
+	assertNoError(t, err)
+	if other == fingerprint {
+		t.Error("expected different fingerprint for different params")
+	}
+}
+
+func TestBatchRunner_Structured(t *testing.T) {
+	var bodies []string
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, err := io.ReadAll(r.Body)
+			assertNoError(t, err)
+			bodies = append(bodies, string(body))
+			w.Write([]byte(`{"id":"job-1","status":"pending"}`))
+			return
+		}
+		w.Write([]byte(`[{"city":"Tacoma","lat":1,"lon":1}]`))
+	})
+
+	_, err := client.BatchGeocoding().
+		SubmitForwardStructured([]StructuredAddress{{City: "Tacoma"}}).
+		Runner().
+		Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, bodies[0], `[{"city":"Tacoma"}]`)
+}
@@ -0,0 +1,173 @@
+package geoapify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBatch_MixedItems(t *testing.T) {
+	var submitted []batchJob
+	polls := map[string]int{}
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assertEqual(t, r.URL.Path, "/v1/batch")
+		switch r.Method {
+		case http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			assertNoError(t, err)
+			var job batchJob
+			assertNoError(t, json.Unmarshal(body, &job))
+			submitted = append(submitted, job)
+			w.WriteHeader(http.StatusAccepted)
+			w.Write(mustJSON(t, BatchJobResponse{ID: job.API, Status: "pending"}))
+		case http.MethodGet:
+			id := r.URL.Query().Get("id")
+			polls[id]++
+			if polls[id] == 1 {
+				w.WriteHeader(http.StatusAccepted)
+				w.Write([]byte(`{"id":"` + id + `","status":"pending"}`))
+				return
+			}
+			switch id {
+			case "/v1/routing":
+				w.Write([]byte(`{"id":"/v1/routing","status":"finished","results":[
+					{"id":"2","result":{"type":"FeatureCollection","features":[{"type":"Feature","properties":{"distance":1200,"waypoints":[{"location":[2.35,48.85],"original_index":0}]}}]}},
+					{"id":"0","result":{"type":"FeatureCollection","features":[{"type":"Feature","properties":{"distance":500}}]}}
+				]}`))
+			case "/v1/geocode/reverse":
+				w.Write([]byte(`{"id":"/v1/geocode/reverse","status":"finished","results":[
+					{"id":"1","result":{"statusCode":400,"error":"Bad Request","message":"Invalid coordinates"}}
+				]}`))
+			}
+		}
+	})
+
+	results, err := client.Batch().Submit(
+		client.Routing().Waypoints(LatLon(48.85, 2.35), LatLon(48.86, 2.36)).WithMode(ModeWalk),
+		client.Geocoding().Reverse(200, 0),
+		client.Routing().Waypoints(LatLon(48.8501, 2.3501), LatLon(48.87, 2.37)),
+		client.Geocoding().Search("Berlin").WithFilter(CountryFilter("")),
+	).WithPollInterval(time.Millisecond, time.Millisecond).Do(context.Background())
+	assertNoError(t, err)
+
+	assertEqual(t, len(submitted), 2)
+	assertEqual(t, submitted[0].API, "/v1/routing")
+	assertEqual(t, len(submitted[0].Inputs), 2)
+	assertEqual(t, submitted[0].Inputs[0].ID, "0")
+	assertEqual(t, submitted[0].Inputs[0].Params["mode"], "walk")
+	assertEqual(t, submitted[0].Inputs[1].ID, "2")
+	assertEqual(t, submitted[1].API, "/v1/geocode/reverse")
+	assertEqual(t, submitted[1].Inputs[0].Params["lat"], "200.000000")
+
+	assertEqual(t, len(results), 4)
+
+	first, err := BatchValue[*RoutingResponse](results[0])
+	assertNoError(t, err)
+	assertEqual(t, first.Results[0].Distance, 500.0)
+
+	third, err := BatchValue[*RoutingResponse](results[2])
+	assertNoError(t, err)
+	assertEqual(t, third.Results[0].Distance, 1200.0)
+	assertEqual(t, third.Results[0].Waypoints[0].OriginalLocation, LatLon(48.8501, 2.3501))
+
+	_, err = BatchValue[*GeocodingResponse](results[1])
+	apiErr, ok := IsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	assertEqual(t, apiErr.StatusCode, 400)
+	assertEqual(t, apiErr.Message, "Invalid coordinates")
+
+	assertError(t, results[3].Err)
+
+	_, err = BatchValue[*GeocodingResponse](results[0])
+	assertError(t, err)
+}
+
+func TestBatch_MissingResult(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Write([]byte(`{"id":"job-1","status":"pending"}`))
+			return
+		}
+		w.Write([]byte(`{"id":"job-1","status":"finished","results":[
+			{"id":"1","result":{"type":"FeatureCollection","features":[]}}
+		]}`))
+	})
+
+	results, err := client.Batch().Submit(
+		client.PlaceDetails().ByID("a"),
+		client.PlaceDetails().ByID("b"),
+	).Do(context.Background())
+	assertNoError(t, err)
+	assertError(t, results[0].Err)
+	details, err := BatchValue[*GeoJSONFeatureCollection](results[1])
+	assertNoError(t, err)
+	assertEqual(t, details.Type, "FeatureCollection")
+}
+
+func TestBatch_JobFailure(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Write([]byte(`{"id":"job-1","status":"pending"}`))
+			return
+		}
+		w.Write([]byte(`{"id":"job-1","status":"failed"}`))
+	})
+
+	_, err := client.Batch().Submit(client.Geocoding().Search("Berlin")).Do(context.Background())
+	assertError(t, err)
+}
+
+func TestBatch_SubmitError(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message":"Invalid API key"}`))
+	})
+
+	_, err := client.Batch().Submit(client.Geocoding().Search("Berlin")).Do(context.Background())
+	apiErr, ok := IsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	assertEqual(t, apiErr.StatusCode, 401)
+
+	_, err = client.Batch().Submit().Do(context.Background())
+	assertError(t, err)
+}
+
+func TestBatch_PartialSubmitError(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var job batchJob
+			assertNoError(t, json.NewDecoder(r.Body).Decode(&job))
+			if job.API == "/v2/place-details" {
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{"message":"Too many requests"}`))
+				return
+			}
+			w.Write([]byte(`{"id":"job-1","status":"pending"}`))
+			return
+		}
+		assertEqual(t, r.URL.Query().Get("id"), "job-1")
+		w.Write([]byte(`{"id":"job-1","status":"finished","results":[
+			{"id":"0","result":{"type":"FeatureCollection","features":[]}}
+		]}`))
+	})
+
+	results, err := client.Batch().Submit(
+		client.Geocoding().Search("Berlin"),
+		client.PlaceDetails().ByID("a"),
+	).WithPollInterval(time.Millisecond, time.Millisecond).Do(context.Background())
+	assertNoError(t, err)
+	_, err = BatchValue[*GeocodingResponse](results[0])
+	assertNoError(t, err)
+	apiErr, ok := IsAPIError(results[1].Err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", results[1].Err)
+	}
+	assertEqual(t, apiErr.StatusCode, 429)
+}
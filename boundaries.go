@@ -2,8 +2,11 @@ package geoapify
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
+	"sync"
 )
 
 // BoundariesService provides access to the GeoApify Boundaries API.
@@ -66,7 +69,7 @@ func (r *BoundariesPartOfRequest) WithLang(v string) *BoundariesPartOfRequest {
 }
 
 // Do executes the boundaries part-of request.
-func (r *BoundariesPartOfRequest) Do(ctx context.Context) (*GeoJSONFeatureCollection, error) {
+func (r *BoundariesPartOfRequest) Do(ctx context.Context) (*BoundariesResponse, error) {
 	params := url.Values{}
 
 	if r.lat != nil && r.lon != nil {
@@ -86,7 +89,7 @@ func (r *BoundariesPartOfRequest) Do(ctx context.Context) (*GeoJSONFeatureCollec
 		params.Set("lang", r.lang)
 	}
 
-	var result GeoJSONFeatureCollection
+	var result BoundariesResponse
 	if err := r.service.client.doGet(ctx, "/v1/boundaries/part-of", params, &result); err != nil {
 		return nil, err
 	}
@@ -128,7 +131,7 @@ func (r *BoundariesConsistsOfRequest) WithSublevel(n int) *BoundariesConsistsOfR
 }
 
 // Do executes the boundaries consists-of request.
-func (r *BoundariesConsistsOfRequest) Do(ctx context.Context) (*GeoJSONFeatureCollection, error) {
+func (r *BoundariesConsistsOfRequest) Do(ctx context.Context) (*BoundariesResponse, error) {
 	params := url.Values{}
 
 	params.Set("id", r.id)
@@ -146,9 +149,191 @@ func (r *BoundariesConsistsOfRequest) Do(ctx context.Context) (*GeoJSONFeatureCo
 		params.Set("sublevel", fmt.Sprintf("%d", r.sublevel))
 	}
 
-	var result GeoJSONFeatureCollection
+	var result BoundariesResponse
 	if err := r.service.client.doGet(ctx, "/v1/boundaries/consists-of", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
+
+// BoundariesResponse is the response from the boundaries API.
+type BoundariesResponse struct {
+	GeoJSONFeatureCollection
+	// Boundaries holds the typed features.
+	Boundaries []Boundary `json:"-"`
+}
+
+// UnmarshalJSON implements custom unmarshalling for BoundariesResponse.
+func (r *BoundariesResponse) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.GeoJSONFeatureCollection); err != nil {
+		return err
+	}
+	r.Boundaries = r.Boundaries[:0]
+	for _, f := range r.Features {
+		b, err := newBoundary(f)
+		if err != nil {
+			return err
+		}
+		r.Boundaries = append(r.Boundaries, b)
+	}
+	return nil
+}
+
+// Boundary is a single administrative, postal or political area.
+type Boundary struct {
+	PlaceID     string
+	Name        string
+	Country     string
+	CountryCode string
+	State       string
+	County      string
+	City        string
+	// AdminLevel is the OpenStreetMap admin_level, or 0 when unknown.
+	AdminLevel int
+	Categories []string
+	// Center is the point geometry, or the lat/lon properties of the feature.
+	Center Location
+	// Geometry is set when a polygon geometry was requested.
+	Geometry MultiPolygon
+	// Properties holds the raw feature properties.
+	Properties map[string]any
+}
+
+func newBoundary(f GeoJSONFeature) (Boundary, error) {
+	var props struct {
+		PlaceID     string   `json:"place_id"`
+		Name        string   `json:"name"`
+		Country     string   `json:"country"`
+		CountryCode string   `json:"country_code"`
+		State       string   `json:"state"`
+		County      string   `json:"county"`
+		City        string   `json:"city"`
+		Categories  []string `json:"categories"`
+		Lat         float64  `json:"lat"`
+		Lon         float64  `json:"lon"`
+		AdminLevel  any      `json:"admin_level"`
+		Datasource  struct {
+			Raw struct {
+				AdminLevel any `json:"admin_level"`
+			} `json:"raw"`
+		} `json:"datasource"`
+	}
+	if err := remarshal(f.Properties, &props); err != nil {
+		return Boundary{}, fmt.Errorf("decoding boundary properties: %w", err)
+	}
+
+	b := Boundary{
+		PlaceID:     props.PlaceID,
+		Name:        props.Name,
+		Country:     props.Country,
+		CountryCode: props.CountryCode,
+		State:       props.State,
+		County:      props.County,
+		City:        props.City,
+		Categories:  props.Categories,
+		Center:      LatLon(props.Lat, props.Lon),
+		Properties:  f.Properties,
+	}
+
+	level := props.AdminLevel
+	if level == nil {
+		level = props.Datasource.Raw.AdminLevel
+	}
+	switch v := level.(type) {
+	case float64:
+		b.AdminLevel = int(v)
+	case string:
+		b.AdminLevel, _ = strconv.Atoi(v)
+	}
+
+	if loc, ok, err := f.Geometry.point(); err != nil {
+		return Boundary{}, fmt.Errorf("decoding boundary: %w", err)
+	} else if ok {
+		b.Center = loc
+	}
+	geometry, err := f.Geometry.Polygons()
+	if err != nil {
+		return Boundary{}, err
+	}
+	b.Geometry = geometry
+	return b, nil
+}
+
+// hierarchyConcurrency bounds the number of concurrent consists-of requests
+// issued by Hierarchy.
+const hierarchyConcurrency = 4
+
+// BoundaryNode is a boundary together with its subdivisions.
+type BoundaryNode struct {
+	Boundary Boundary
+	Children []*BoundaryNode
+}
+
+// Hierarchy walks the subdivisions of the place with the given ID, level by
+// level, down to depth levels, and returns the top-level subdivisions as an
+// in-memory tree. A depth of 1 returns only the direct subdivisions.
+func (s *BoundariesService) Hierarchy(ctx context.Context, id string, depth int) ([]*BoundaryNode, error) {
+	if depth <= 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	root := &BoundaryNode{Boundary: Boundary{PlaceID: id}}
+	level := []*BoundaryNode{root}
+
+	for d := 0; d < depth && len(level) > 0; d++ {
+		var (
+			wg       sync.WaitGroup
+			mu       sync.Mutex
+			firstErr error
+			sem      = make(chan struct{}, hierarchyConcurrency)
+		)
+		for _, node := range level {
+			if node.Boundary.PlaceID == "" {
+				continue
+			}
+			wg.Add(1)
+			go func(node *BoundaryNode) {
+				defer wg.Done()
+				select {
+				case sem <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+				defer func() { <-sem }()
+
+				resp, err := s.ConsistsOf(node.Boundary.PlaceID).Do(ctx)
+				if err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+					mu.Unlock()
+					return
+				}
+				children := make([]*BoundaryNode, len(resp.Boundaries))
+				for i, b := range resp.Boundaries {
+					children[i] = &BoundaryNode{Boundary: b}
+				}
+				node.Children = children
+			}(node)
+		}
+		wg.Wait()
+		if firstErr != nil {
+			return nil, firstErr
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var next []*BoundaryNode
+		for _, node := range level {
+			next = append(next, node.Children...)
+		}
+		level = next
+	}
+	return root.Children, nil
+}
@@ -3,6 +3,7 @@ package geoapify
 import (
 	"context"
 	"net/http"
+	"sync/atomic"
 	"testing"
 )
 
@@ -176,3 +177,83 @@ func TestBoundaries_ConsistsOfErrorHandling(t *testing.T) {
 	assertEqual(t, apiErr.StatusCode, 404)
 	assertEqual(t, apiErr.Message, "Place not found")
 }
+
+func TestBoundaries_TypedResponse(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"type":"FeatureCollection","features":[
+			{"type":"Feature","properties":{
+				"place_id":"state-1","name":"Bavaria","country":"Germany","country_code":"de",
+				"categories":["administrative","administrative.state_level"],
+				"datasource":{"raw":{"admin_level":"4"}}
+			},"geometry":{"type":"Point","coordinates":[11.4,48.9]}},
+			{"type":"Feature","properties":{"place_id":"city-1","name":"Munich","admin_level":8},
+			"geometry":{"type":"Polygon","coordinates":[[[11.3,48.0],[11.7,48.0],[11.7,48.3],[11.3,48.0]]]}}
+		]}`))
+	})
+
+	got, err := client.Boundaries().PartOf(48.1, 11.5).Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, len(got.Features), 2)
+	assertEqual(t, len(got.Boundaries), 2)
+
+	state := got.Boundaries[0]
+	assertEqual(t, state.PlaceID, "state-1")
+	assertEqual(t, state.Name, "Bavaria")
+	assertEqual(t, state.CountryCode, "de")
+	assertEqual(t, state.AdminLevel, 4)
+	assertEqual(t, len(state.Categories), 2)
+	assertEqual(t, state.Center, LonLat(11.4, 48.9))
+	assertEqual(t, len(state.Geometry), 0)
+
+	city := got.Boundaries[1]
+	assertEqual(t, city.AdminLevel, 8)
+	assertEqual(t, len(city.Geometry), 1)
+	assertEqual(t, city.Geometry[0][0][1], LonLat(11.7, 48.0))
+}
+
+func TestBoundaries_Hierarchy(t *testing.T) {
+	children := map[string][]string{
+		"country":   {"state-a", "state-b"},
+		"state-a":   {"county-a1"},
+		"state-b":   {"county-b1", "county-b2"},
+		"county-b1": {"city-b1x"},
+	}
+
+	var calls atomic.Int32
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		assertEqual(t, r.URL.Path, "/v1/boundaries/consists-of")
+		fc := GeoJSONFeatureCollection{Type: "FeatureCollection", Features: []GeoJSONFeature{}}
+		for _, id := range children[r.URL.Query().Get("id")] {
+			fc.Features = append(fc.Features, GeoJSONFeature{
+				Type:       "Feature",
+				Properties: map[string]any{"place_id": id, "name": id},
+			})
+		}
+		w.Write(mustJSON(t, fc))
+	})
+
+	tree, err := client.Boundaries().Hierarchy(context.Background(), "country", 2)
+	assertNoError(t, err)
+	assertEqual(t, len(tree), 2)
+	assertEqual(t, tree[0].Boundary.Name, "state-a")
+	assertEqual(t, len(tree[0].Children), 1)
+	assertEqual(t, tree[1].Boundary.Name, "state-b")
+	assertEqual(t, len(tree[1].Children), 2)
+	assertEqual(t, tree[1].Children[0].Boundary.PlaceID, "county-b1")
+	assertEqual(t, len(tree[1].Children[0].Children), 0)
+	assertEqual(t, calls.Load(), int32(3))
+}
+
+func TestBoundaries_HierarchyError(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message":"Unknown id"}`))
+	})
+
+	_, err := client.Boundaries().Hierarchy(context.Background(), "nope", 3)
+	assertError(t, err)
+	if _, ok := IsAPIError(err); !ok {
+		t.Fatal("expected APIError")
+	}
+}
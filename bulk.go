@@ -0,0 +1,132 @@
+package geoapify
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+const defaultBulkWorkers = 4
+
+// ErrBulkStopped is the error of items that were not run because a bulk run
+// stopped on an earlier error.
+var ErrBulkStopped = errors.New("geoapify: bulk run stopped")
+
+// BulkRequest runs many single-call requests concurrently. Create one with
+// Bulk.
+type BulkRequest[T any] struct {
+	calls       []func(context.Context) (T, error)
+	workers     int
+	stopOnError bool
+}
+
+// BulkResult is the outcome of one request of a bulk run.
+type BulkResult[T any] struct {
+	Value T
+	Err   error
+}
+
+// Bulk creates a bulk run over request builders that share a response type,
+// such as SearchRequest, ReverseGeocodingRequest or PlaceDetailsRequest.
+// Requests go through the client's retry and rate limit settings.
+//
+//	searches := make([]*geoapify.SearchRequest, len(addresses))
+//	for i, a := range addresses {
+//	    searches[i] = client.Geocoding().Search(a)
+//	}
+//	results, err := geoapify.Bulk[*geoapify.GeocodingResponse](searches).WithWorkers(8).Do(ctx)
+func Bulk[T any, R interface {
+	Do(context.Context) (T, error)
+}](requests []R) *BulkRequest[T] {
+	calls := make([]func(context.Context) (T, error), len(requests))
+	for i, r := range requests {
+		calls[i] = r.Do
+	}
+	return &BulkRequest[T]{calls: calls}
+}
+
+// BulkFuncs creates a bulk run over arbitrary calls, e.g. method values of
+// request builders with different options.
+func BulkFuncs[T any](calls ...func(context.Context) (T, error)) *BulkRequest[T] {
+	return &BulkRequest[T]{calls: calls}
+}
+
+// WithWorkers sets how many requests run at once. The default is 4.
+func (b *BulkRequest[T]) WithWorkers(n int) *BulkRequest[T] {
+	b.workers = n
+	return b
+}
+
+// WithStopOnError stops starting new requests after the first failure.
+// Requests already in flight are canceled.
+func (b *BulkRequest[T]) WithStopOnError(v bool) *BulkRequest[T] {
+	b.stopOnError = v
+	return b
+}
+
+// Do runs every request and returns one result per request, in order. Each
+// failed request carries its own error. The returned error is the context's
+// error if ctx was canceled, the first request error when stopping on
+// errors, and nil otherwise. Requests that never ran get ctx's error or
+// ErrBulkStopped.
+func (b *BulkRequest[T]) Do(ctx context.Context) ([]BulkResult[T], error) {
+	results := make([]BulkResult[T], len(b.calls))
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		once     sync.Once
+		firstErr error
+		started  = make([]bool, len(b.calls))
+	)
+
+	workers := b.workers
+	if workers <= 0 {
+		workers = defaultBulkWorkers
+	}
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, len(b.calls)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				v, err := b.calls[i](runCtx)
+				results[i] = BulkResult[T]{Value: v, Err: err}
+				if err != nil && b.stopOnError {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+feed:
+	for i := range b.calls {
+		select {
+		case next <- i:
+			started[i] = true
+		case <-runCtx.Done():
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+
+	notRun := ErrBulkStopped
+	if err := ctx.Err(); err != nil {
+		notRun = err
+	}
+	for i, ok := range started {
+		if !ok {
+			results[i].Err = notRun
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return results, err
+	}
+	return results, firstErr
+}
@@ -0,0 +1,128 @@
+package geoapify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBulk_OrderedResults(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		text := r.URL.Query().Get("text")
+		if text == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message":"Invalid text"}`))
+			return
+		}
+		w.Write(mustJSON(t, GeocodingResponse{Results: []Address{{City: text}}}))
+	})
+
+	texts := []string{"a", "b", "bad", "c", "d", "e"}
+	searches := make([]*SearchRequest, len(texts))
+	for i, text := range texts {
+		searches[i] = client.Geocoding().Search(text)
+	}
+
+	results, err := Bulk[*GeocodingResponse](searches).WithWorkers(2).Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, len(results), len(texts))
+	for i, res := range results {
+		if texts[i] == "bad" {
+			apiErr, ok := IsAPIError(res.Err)
+			if !ok {
+				t.Fatalf("expected APIError, got %v", res.Err)
+			}
+			assertEqual(t, apiErr.StatusCode, 400)
+			continue
+		}
+		assertNoError(t, res.Err)
+		assertEqual(t, res.Value.Results[0].City, texts[i])
+	}
+	if peak.Load() > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %d", peak.Load())
+	}
+}
+
+func TestBulk_StopOnError(t *testing.T) {
+	var calls atomic.Int32
+	fail := errors.New("boom")
+	call := func(i int) func(context.Context) (int, error) {
+		return func(ctx context.Context) (int, error) {
+			calls.Add(1)
+			if i == 1 {
+				return 0, fail
+			}
+			return i, nil
+		}
+	}
+
+	fns := make([]func(context.Context) (int, error), 20)
+	for i := range fns {
+		fns[i] = call(i)
+	}
+	results, err := BulkFuncs(fns...).WithWorkers(1).WithStopOnError(true).Do(context.Background())
+	if !errors.Is(err, fail) {
+		t.Fatalf("expected first error, got %v", err)
+	}
+	assertEqual(t, results[0].Value, 0)
+	assertNoError(t, results[0].Err)
+	if !errors.Is(results[1].Err, fail) {
+		t.Fatalf("expected item error, got %v", results[1].Err)
+	}
+	if !errors.Is(results[19].Err, ErrBulkStopped) {
+		t.Fatalf("expected ErrBulkStopped, got %v", results[19].Err)
+	}
+	if calls.Load() >= 20 {
+		t.Errorf("expected the run to stop early, got %d calls", calls.Load())
+	}
+}
+
+func TestBulk_ContinueOnError(t *testing.T) {
+	fns := []func(context.Context) (int, error){
+		func(context.Context) (int, error) { return 0, errors.New("boom") },
+		func(context.Context) (int, error) { return 2, nil },
+	}
+	results, err := BulkFuncs(fns...).Do(context.Background())
+	assertNoError(t, err)
+	assertError(t, results[0].Err)
+	assertEqual(t, results[1].Value, 2)
+}
+
+func TestBulk_Cancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fns := make([]func(context.Context) (int, error), 10)
+	for i := range fns {
+		fns[i] = func(ctx context.Context) (int, error) {
+			if i == 0 {
+				cancel()
+			}
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}
+	}
+
+	results, err := BulkFuncs(fns...).WithWorkers(1).Do(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	for _, res := range results {
+		if !errors.Is(res.Err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", res.Err)
+		}
+	}
+}
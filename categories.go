@@ -0,0 +1,1702 @@
+package geoapify
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Category is a Places API category. Categories form a tree whose levels
+// are separated by dots, e.g. "catering.restaurant.pizza"; a category
+// matches every place in its subtree.
+type Category string
+
+// Parent returns the category one level up, or "" for a top-level
+// category.
+func (c Category) Parent() Category {
+	i := strings.LastIndexByte(string(c), '.')
+	if i < 0 {
+		return ""
+	}
+	return c[:i]
+}
+
+// Includes reports whether other is c or one of its subcategories. A
+// request for "catering" matches places in "catering.cafe.coffee".
+func (c Category) Includes(other Category) bool {
+	return other == c || strings.HasPrefix(string(other), string(c)+".")
+}
+
+// Children returns the known direct subcategories of c, sorted.
+func (c Category) Children() []Category {
+	var children []Category
+	for _, k := range knownCategories {
+		if k.Parent() == c && k != c {
+			children = append(children, k)
+		}
+	}
+	return children
+}
+
+// Valid reports whether c is a known category.
+func (c Category) Valid() bool {
+	_, ok := slices.BinarySearch(knownCategories, c)
+	return ok
+}
+
+// TopLevelCategories returns the roots of the category tree, sorted.
+func TopLevelCategories() []Category {
+	return Category("").Children()
+}
+
+// ParseCategory checks that s is a known category. The error wraps
+// ErrInvalidArgument and suggests the closest known category when s looks
+// like a typo.
+func ParseCategory(s string) (Category, error) {
+	c := Category(strings.TrimSpace(s))
+	if c.Valid() {
+		return c, nil
+	}
+	if best := closestCategory(c); best != "" {
+		return "", fmt.Errorf("%w: unknown category %q (did you mean %q?)", ErrInvalidArgument, s, best)
+	}
+	return "", fmt.Errorf("%w: unknown category %q", ErrInvalidArgument, s)
+}
+
+// validateCategories checks that every category is known, reporting the
+// first unknown one as ParseCategory does.
+func validateCategories(categories []Category) error {
+	for _, c := range categories {
+		if !c.Valid() {
+			_, err := ParseCategory(string(c))
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseCategories parses a comma-separated list of categories, as accepted
+// by the categories parameter of the Places API.
+func ParseCategories(s string) ([]Category, error) {
+	var categories []Category
+	for _, part := range strings.Split(s, ",") {
+		c, err := ParseCategory(part)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, c)
+	}
+	return categories, nil
+}
+
+// closestCategory returns the known category with the smallest edit
+// distance to c, provided the distance is small enough to be a typo.
+func closestCategory(c Category) Category {
+	var best Category
+	bestDist := len(c)/4 + 1
+	for _, k := range knownCategories {
+		if d := editDistance(string(c), string(k)); d < bestDist {
+			best, bestDist = k, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// CategoryForOSMTag returns the category of places carrying the
+// OpenStreetMap tag key=value, e.g. ("amenity", "cafe"). It covers the most
+// common tags; ok is false for tags without a known mapping.
+func CategoryForOSMTag(key, value string) (c Category, ok bool) {
+	c, ok = osmTagCategories[key+"="+value]
+	return c, ok
+}
+
+// Condition is a Places API condition that every result must satisfy.
+type Condition string
+
+const (
+	ConditionInternetAccess             Condition = "internet_access"
+	ConditionInternetAccessFree         Condition = "internet_access.free"
+	ConditionInternetAccessForCustomers Condition = "internet_access.for_customers"
+	ConditionWheelchair                 Condition = "wheelchair"
+	ConditionWheelchairLimited          Condition = "wheelchair.limited"
+	ConditionDogs                       Condition = "dogs"
+	ConditionNoDogs                     Condition = "no-dogs"
+	ConditionAccess                     Condition = "access"
+	ConditionAccessYes                  Condition = "access.yes"
+	ConditionAccessNotSpecified         Condition = "access.not_specified"
+	ConditionAccessLimited              Condition = "access_limited"
+	ConditionNoAccess                   Condition = "no_access"
+	ConditionFee                        Condition = "fee"
+	ConditionNoFee                      Condition = "no_fee"
+	ConditionNamed                      Condition = "named"
+	ConditionVegetarian                 Condition = "vegetarian"
+	ConditionVegetarianOnly             Condition = "vegetarian.only"
+	ConditionVegan                      Condition = "vegan"
+	ConditionVeganOnly                  Condition = "vegan.only"
+	ConditionHalal                      Condition = "halal"
+	ConditionHalalOnly                  Condition = "halal.only"
+	ConditionKosher                     Condition = "kosher"
+	ConditionKosherOnly                 Condition = "kosher.only"
+	ConditionOrganic                    Condition = "organic"
+	ConditionOrganicOnly                Condition = "organic.only"
+	ConditionGlutenFree                 Condition = "gluten_free"
+	ConditionSugarFree                  Condition = "sugar_free"
+	ConditionEggFree                    Condition = "egg_free"
+	ConditionSoyFree                    Condition = "soy_free"
+)
+
+// knownConditions lists every condition of the Places API.
+var knownConditions = []Condition{
+	ConditionInternetAccess, ConditionInternetAccessFree, ConditionInternetAccessForCustomers,
+	ConditionWheelchair, ConditionWheelchairLimited,
+	ConditionDogs, ConditionNoDogs,
+	ConditionAccess, ConditionAccessYes, ConditionAccessNotSpecified, ConditionAccessLimited, ConditionNoAccess,
+	ConditionFee, ConditionNoFee, ConditionNamed,
+	ConditionVegetarian, ConditionVegetarianOnly, ConditionVegan, ConditionVeganOnly,
+	ConditionHalal, ConditionHalalOnly, ConditionKosher, ConditionKosherOnly,
+	ConditionOrganic, ConditionOrganicOnly,
+	ConditionGlutenFree, ConditionSugarFree, ConditionEggFree, ConditionSoyFree,
+}
+
+// Valid reports whether c is a known condition.
+func (c Condition) Valid() bool {
+	return slices.Contains(knownConditions, c)
+}
+
+// ParseCondition checks that s is a known condition. The error wraps
+// ErrInvalidArgument.
+func ParseCondition(s string) (Condition, error) {
+	c := Condition(strings.TrimSpace(s))
+	if !c.Valid() {
+		return "", fmt.Errorf("%w: unknown condition %q", ErrInvalidArgument, s)
+	}
+	return c, nil
+}
+
+// validateConditions checks that every condition is known.
+func validateConditions(conditions []Condition) error {
+	for _, c := range conditions {
+		if _, err := ParseCondition(string(c)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func joinCategories(categories []Category) string {
+	parts := make([]string, len(categories))
+	for i, c := range categories {
+		parts[i] = string(c)
+	}
+	return strings.Join(parts, ",")
+}
+
+func joinConditions(conditions []Condition) string {
+	parts := make([]string, len(conditions))
+	for i, c := range conditions {
+		parts[i] = string(c)
+	}
+	return strings.Join(parts, ",")
+}
+
+const (
+	CategoryAccommodation           Category = "accommodation"
+	CategoryAccommodationApartment  Category = "accommodation.apartment"
+	CategoryAccommodationChalet     Category = "accommodation.chalet"
+	CategoryAccommodationGuestHouse Category = "accommodation.guest_house"
+	CategoryAccommodationHostel     Category = "accommodation.hostel"
+	CategoryAccommodationHotel      Category = "accommodation.hotel"
+	CategoryAccommodationHut        Category = "accommodation.hut"
+	CategoryAccommodationMotel      Category = "accommodation.motel"
+
+	CategoryActivity                Category = "activity"
+	CategoryActivityCommunityCenter Category = "activity.community_center"
+	CategoryActivitySportClub       Category = "activity.sport_club"
+
+	CategoryAdministrative                   Category = "administrative"
+	CategoryAdministrativeCityLevel          Category = "administrative.city_level"
+	CategoryAdministrativeContinentLevel     Category = "administrative.continent_level"
+	CategoryAdministrativeCountryLevel       Category = "administrative.country_level"
+	CategoryAdministrativeCountryPartLevel   Category = "administrative.country_part_level"
+	CategoryAdministrativeCountyLevel        Category = "administrative.county_level"
+	CategoryAdministrativeDistrictLevel      Category = "administrative.district_level"
+	CategoryAdministrativeNeighbourhoodLevel Category = "administrative.neighbourhood_level"
+	CategoryAdministrativeStateLevel         Category = "administrative.state_level"
+	CategoryAdministrativeSuburbLevel        Category = "administrative.suburb_level"
+
+	CategoryAdult                  Category = "adult"
+	CategoryAdultAdultGamingCentre Category = "adult.adult_gaming_centre"
+	CategoryAdultCasino            Category = "adult.casino"
+	CategoryAdultNightclub         Category = "adult.nightclub"
+	CategoryAdultStripclub         Category = "adult.stripclub"
+	CategoryAdultSwingerclub       Category = "adult.swingerclub"
+
+	CategoryAirport              Category = "airport"
+	CategoryAirportInternational Category = "airport.international"
+
+	CategoryAmenity              Category = "amenity"
+	CategoryAmenityDrinkingWater Category = "amenity.drinking_water"
+	CategoryAmenityGiveBox       Category = "amenity.give_box"
+	CategoryAmenityToilet        Category = "amenity.toilet"
+
+	CategoryBeach            Category = "beach"
+	CategoryBeachBeachResort Category = "beach.beach_resort"
+
+	CategoryBuilding               Category = "building"
+	CategoryBuildingAccommodation  Category = "building.accommodation"
+	CategoryBuildingCatering       Category = "building.catering"
+	CategoryBuildingCollege        Category = "building.college"
+	CategoryBuildingCommercial     Category = "building.commercial"
+	CategoryBuildingDormitory      Category = "building.dormitory"
+	CategoryBuildingDrivingSchool  Category = "building.driving_school"
+	CategoryBuildingEntertainment  Category = "building.entertainment"
+	CategoryBuildingFacility       Category = "building.facility"
+	CategoryBuildingGarage         Category = "building.garage"
+	CategoryBuildingHealthcare     Category = "building.healthcare"
+	CategoryBuildingHistoric       Category = "building.historic"
+	CategoryBuildingHolidayHouse   Category = "building.holiday_house"
+	CategoryBuildingIndustrial     Category = "building.industrial"
+	CategoryBuildingKindergarten   Category = "building.kindergarten"
+	CategoryBuildingMilitary       Category = "building.military"
+	CategoryBuildingOffice         Category = "building.office"
+	CategoryBuildingParking        Category = "building.parking"
+	CategoryBuildingPlaceOfWorship Category = "building.place_of_worship"
+	CategoryBuildingPrison         Category = "building.prison"
+	CategoryBuildingPublicAndCivil Category = "building.public_and_civil"
+	CategoryBuildingResidential    Category = "building.residential"
+	CategoryBuildingSchool         Category = "building.school"
+	CategoryBuildingService        Category = "building.service"
+	CategoryBuildingSpa            Category = "building.spa"
+	CategoryBuildingSport          Category = "building.sport"
+	CategoryBuildingToilet         Category = "building.toilet"
+	CategoryBuildingTourism        Category = "building.tourism"
+	CategoryBuildingTransportation Category = "building.transportation"
+	CategoryBuildingUniversity     Category = "building.university"
+
+	CategoryCamping            Category = "camping"
+	CategoryCampingCampPitch   Category = "camping.camp_pitch"
+	CategoryCampingCampSite    Category = "camping.camp_site"
+	CategoryCampingCaravanSite Category = "camping.caravan_site"
+	CategoryCampingSummerCamp  Category = "camping.summer_camp"
+
+	CategoryCatering                        Category = "catering"
+	CategoryCateringBar                     Category = "catering.bar"
+	CategoryCateringBiergarten              Category = "catering.biergarten"
+	CategoryCateringCafe                    Category = "catering.cafe"
+	CategoryCateringCafeBubbleTea           Category = "catering.cafe.bubble_tea"
+	CategoryCateringCafeCake                Category = "catering.cafe.cake"
+	CategoryCateringCafeCoffee              Category = "catering.cafe.coffee"
+	CategoryCateringCafeCoffeeShop          Category = "catering.cafe.coffee_shop"
+	CategoryCateringCafeCrepe               Category = "catering.cafe.crepe"
+	CategoryCateringCafeDessert             Category = "catering.cafe.dessert"
+	CategoryCateringCafeDonut               Category = "catering.cafe.donut"
+	CategoryCateringCafeFrozenYogurt        Category = "catering.cafe.frozen_yogurt"
+	CategoryCateringCafeIceCream            Category = "catering.cafe.ice_cream"
+	CategoryCateringCafeTea                 Category = "catering.cafe.tea"
+	CategoryCateringCafeWaffle              Category = "catering.cafe.waffle"
+	CategoryCateringFastFood                Category = "catering.fast_food"
+	CategoryCateringFastFoodBurger          Category = "catering.fast_food.burger"
+	CategoryCateringFastFoodChicken         Category = "catering.fast_food.chicken"
+	CategoryCateringFastFoodFishAndChips    Category = "catering.fast_food.fish_and_chips"
+	CategoryCateringFastFoodHotDog          Category = "catering.fast_food.hot_dog"
+	CategoryCateringFastFoodKebab           Category = "catering.fast_food.kebab"
+	CategoryCateringFastFoodNoodle          Category = "catering.fast_food.noodle"
+	CategoryCateringFastFoodPizza           Category = "catering.fast_food.pizza"
+	CategoryCateringFastFoodRamen           Category = "catering.fast_food.ramen"
+	CategoryCateringFastFoodSalad           Category = "catering.fast_food.salad"
+	CategoryCateringFastFoodSandwich        Category = "catering.fast_food.sandwich"
+	CategoryCateringFastFoodSoup            Category = "catering.fast_food.soup"
+	CategoryCateringFastFoodTacos           Category = "catering.fast_food.tacos"
+	CategoryCateringFastFoodTapas           Category = "catering.fast_food.tapas"
+	CategoryCateringFastFoodWings           Category = "catering.fast_food.wings"
+	CategoryCateringFoodCourt               Category = "catering.food_court"
+	CategoryCateringIceCream                Category = "catering.ice_cream"
+	CategoryCateringPub                     Category = "catering.pub"
+	CategoryCateringRestaurant              Category = "catering.restaurant"
+	CategoryCateringRestaurantAfghan        Category = "catering.restaurant.afghan"
+	CategoryCateringRestaurantAfrican       Category = "catering.restaurant.african"
+	CategoryCateringRestaurantAmerican      Category = "catering.restaurant.american"
+	CategoryCateringRestaurantArab          Category = "catering.restaurant.arab"
+	CategoryCateringRestaurantArgentinian   Category = "catering.restaurant.argentinian"
+	CategoryCateringRestaurantAsian         Category = "catering.restaurant.asian"
+	CategoryCateringRestaurantAustrian      Category = "catering.restaurant.austrian"
+	CategoryCateringRestaurantBalkan        Category = "catering.restaurant.balkan"
+	CategoryCateringRestaurantBarbecue      Category = "catering.restaurant.barbecue"
+	CategoryCateringRestaurantBavarian      Category = "catering.restaurant.bavarian"
+	CategoryCateringRestaurantBeefBowl      Category = "catering.restaurant.beef_bowl"
+	CategoryCateringRestaurantBelgian       Category = "catering.restaurant.belgian"
+	CategoryCateringRestaurantBolivian      Category = "catering.restaurant.bolivian"
+	CategoryCateringRestaurantBrazilian     Category = "catering.restaurant.brazilian"
+	CategoryCateringRestaurantBurger        Category = "catering.restaurant.burger"
+	CategoryCateringRestaurantCaribbean     Category = "catering.restaurant.caribbean"
+	CategoryCateringRestaurantChicken       Category = "catering.restaurant.chicken"
+	CategoryCateringRestaurantChili         Category = "catering.restaurant.chili"
+	CategoryCateringRestaurantChinese       Category = "catering.restaurant.chinese"
+	CategoryCateringRestaurantCroatian      Category = "catering.restaurant.croatian"
+	CategoryCateringRestaurantCuban         Category = "catering.restaurant.cuban"
+	CategoryCateringRestaurantCurry         Category = "catering.restaurant.curry"
+	CategoryCateringRestaurantCzech         Category = "catering.restaurant.czech"
+	CategoryCateringRestaurantDanish        Category = "catering.restaurant.danish"
+	CategoryCateringRestaurantDumpling      Category = "catering.restaurant.dumpling"
+	CategoryCateringRestaurantEthiopian     Category = "catering.restaurant.ethiopian"
+	CategoryCateringRestaurantEuropean      Category = "catering.restaurant.european"
+	CategoryCateringRestaurantFilipino      Category = "catering.restaurant.filipino"
+	CategoryCateringRestaurantFish          Category = "catering.restaurant.fish"
+	CategoryCateringRestaurantFishAndChips  Category = "catering.restaurant.fish_and_chips"
+	CategoryCateringRestaurantFrench        Category = "catering.restaurant.french"
+	CategoryCateringRestaurantFriture       Category = "catering.restaurant.friture"
+	CategoryCateringRestaurantGeorgian      Category = "catering.restaurant.georgian"
+	CategoryCateringRestaurantGerman        Category = "catering.restaurant.german"
+	CategoryCateringRestaurantGreek         Category = "catering.restaurant.greek"
+	CategoryCateringRestaurantHawaiian      Category = "catering.restaurant.hawaiian"
+	CategoryCateringRestaurantHungarian     Category = "catering.restaurant.hungarian"
+	CategoryCateringRestaurantIndian        Category = "catering.restaurant.indian"
+	CategoryCateringRestaurantIndonesian    Category = "catering.restaurant.indonesian"
+	CategoryCateringRestaurantInternational Category = "catering.restaurant.international"
+	CategoryCateringRestaurantIrish         Category = "catering.restaurant.irish"
+	CategoryCateringRestaurantItalian       Category = "catering.restaurant.italian"
+	CategoryCateringRestaurantJamaican      Category = "catering.restaurant.jamaican"
+	CategoryCateringRestaurantJapanese      Category = "catering.restaurant.japanese"
+	CategoryCateringRestaurantKebab         Category = "catering.restaurant.kebab"
+	CategoryCateringRestaurantKorean        Category = "catering.restaurant.korean"
+	CategoryCateringRestaurantLatinAmerican Category = "catering.restaurant.latin_american"
+	CategoryCateringRestaurantLebanese      Category = "catering.restaurant.lebanese"
+	CategoryCateringRestaurantMalay         Category = "catering.restaurant.malay"
+	CategoryCateringRestaurantMalaysian     Category = "catering.restaurant.malaysian"
+	CategoryCateringRestaurantMediterranean Category = "catering.restaurant.mediterranean"
+	CategoryCateringRestaurantMexican       Category = "catering.restaurant.mexican"
+	CategoryCateringRestaurantMoroccan      Category = "catering.restaurant.moroccan"
+	CategoryCateringRestaurantNepalese      Category = "catering.restaurant.nepalese"
+	CategoryCateringRestaurantNoodle        Category = "catering.restaurant.noodle"
+	CategoryCateringRestaurantOriental      Category = "catering.restaurant.oriental"
+	CategoryCateringRestaurantPakistani     Category = "catering.restaurant.pakistani"
+	CategoryCateringRestaurantPersian       Category = "catering.restaurant.persian"
+	CategoryCateringRestaurantPeruvian      Category = "catering.restaurant.peruvian"
+	CategoryCateringRestaurantPita          Category = "catering.restaurant.pita"
+	CategoryCateringRestaurantPizza         Category = "catering.restaurant.pizza"
+	CategoryCateringRestaurantPortuguese    Category = "catering.restaurant.portuguese"
+	CategoryCateringRestaurantRamen         Category = "catering.restaurant.ramen"
+	CategoryCateringRestaurantRegional      Category = "catering.restaurant.regional"
+	CategoryCateringRestaurantRussian       Category = "catering.restaurant.russian"
+	CategoryCateringRestaurantSandwich      Category = "catering.restaurant.sandwich"
+	CategoryCateringRestaurantSeafood       Category = "catering.restaurant.seafood"
+	CategoryCateringRestaurantSoup          Category = "catering.restaurant.soup"
+	CategoryCateringRestaurantSpanish       Category = "catering.restaurant.spanish"
+	CategoryCateringRestaurantSteakHouse    Category = "catering.restaurant.steak_house"
+	CategoryCateringRestaurantSushi         Category = "catering.restaurant.sushi"
+	CategoryCateringRestaurantSwedish       Category = "catering.restaurant.swedish"
+	CategoryCateringRestaurantSyrian        Category = "catering.restaurant.syrian"
+	CategoryCateringRestaurantTacos         Category = "catering.restaurant.tacos"
+	CategoryCateringRestaurantTaiwanese     Category = "catering.restaurant.taiwanese"
+	CategoryCateringRestaurantTapas         Category = "catering.restaurant.tapas"
+	CategoryCateringRestaurantTexMex        Category = "catering.restaurant.tex-mex"
+	CategoryCateringRestaurantThai          Category = "catering.restaurant.thai"
+	CategoryCateringRestaurantTurkish       Category = "catering.restaurant.turkish"
+	CategoryCateringRestaurantUkrainian     Category = "catering.restaurant.ukrainian"
+	CategoryCateringRestaurantUzbek         Category = "catering.restaurant.uzbek"
+	CategoryCateringRestaurantVietnamese    Category = "catering.restaurant.vietnamese"
+	CategoryCateringRestaurantWestern       Category = "catering.restaurant.western"
+	CategoryCateringRestaurantWings         Category = "catering.restaurant.wings"
+	CategoryCateringTaproom                 Category = "catering.taproom"
+
+	CategoryChildcare             Category = "childcare"
+	CategoryChildcareKindergarten Category = "childcare.kindergarten"
+
+	CategoryCommercial                                              Category = "commercial"
+	CategoryCommercialAgrarian                                      Category = "commercial.agrarian"
+	CategoryCommercialAntiques                                      Category = "commercial.antiques"
+	CategoryCommercialArt                                           Category = "commercial.art"
+	CategoryCommercialBabyGoods                                     Category = "commercial.baby_goods"
+	CategoryCommercialBag                                           Category = "commercial.bag"
+	CategoryCommercialBooks                                         Category = "commercial.books"
+	CategoryCommercialChemist                                       Category = "commercial.chemist"
+	CategoryCommercialClothing                                      Category = "commercial.clothing"
+	CategoryCommercialClothingAccessories                           Category = "commercial.clothing.accessories"
+	CategoryCommercialClothingClothes                               Category = "commercial.clothing.clothes"
+	CategoryCommercialClothingKids                                  Category = "commercial.clothing.kids"
+	CategoryCommercialClothingMen                                   Category = "commercial.clothing.men"
+	CategoryCommercialClothingShoes                                 Category = "commercial.clothing.shoes"
+	CategoryCommercialClothingSport                                 Category = "commercial.clothing.sport"
+	CategoryCommercialClothingUnderwear                             Category = "commercial.clothing.underwear"
+	CategoryCommercialClothingWomen                                 Category = "commercial.clothing.women"
+	CategoryCommercialConvenience                                   Category = "commercial.convenience"
+	CategoryCommercialDepartmentStore                               Category = "commercial.department_store"
+	CategoryCommercialDiscountStore                                 Category = "commercial.discount_store"
+	CategoryCommercialElektronics                                   Category = "commercial.elektronics"
+	CategoryCommercialEnergy                                        Category = "commercial.energy"
+	CategoryCommercialErotic                                        Category = "commercial.erotic"
+	CategoryCommercialFlorist                                       Category = "commercial.florist"
+	CategoryCommercialFoodAndDrink                                  Category = "commercial.food_and_drink"
+	CategoryCommercialFoodAndDrinkBakery                            Category = "commercial.food_and_drink.bakery"
+	CategoryCommercialFoodAndDrinkButcher                           Category = "commercial.food_and_drink.butcher"
+	CategoryCommercialFoodAndDrinkCheeseAndDairy                    Category = "commercial.food_and_drink.cheese_and_dairy"
+	CategoryCommercialFoodAndDrinkChocolate                         Category = "commercial.food_and_drink.chocolate"
+	CategoryCommercialFoodAndDrinkCoffeeAndTea                      Category = "commercial.food_and_drink.coffee_and_tea"
+	CategoryCommercialFoodAndDrinkConfectionery                     Category = "commercial.food_and_drink.confectionery"
+	CategoryCommercialFoodAndDrinkDeli                              Category = "commercial.food_and_drink.deli"
+	CategoryCommercialFoodAndDrinkDrinks                            Category = "commercial.food_and_drink.drinks"
+	CategoryCommercialFoodAndDrinkFarm                              Category = "commercial.food_and_drink.farm"
+	CategoryCommercialFoodAndDrinkFrozenFood                        Category = "commercial.food_and_drink.frozen_food"
+	CategoryCommercialFoodAndDrinkFruitAndVegetable                 Category = "commercial.food_and_drink.fruit_and_vegetable"
+	CategoryCommercialFoodAndDrinkHealthFood                        Category = "commercial.food_and_drink.health_food"
+	CategoryCommercialFoodAndDrinkHoney                             Category = "commercial.food_and_drink.honey"
+	CategoryCommercialFoodAndDrinkIceCream                          Category = "commercial.food_and_drink.ice_cream"
+	CategoryCommercialFoodAndDrinkNuts                              Category = "commercial.food_and_drink.nuts"
+	CategoryCommercialFoodAndDrinkOrganic                           Category = "commercial.food_and_drink.organic"
+	CategoryCommercialFoodAndDrinkPasta                             Category = "commercial.food_and_drink.pasta"
+	CategoryCommercialFoodAndDrinkRice                              Category = "commercial.food_and_drink.rice"
+	CategoryCommercialFoodAndDrinkSeafood                           Category = "commercial.food_and_drink.seafood"
+	CategoryCommercialFoodAndDrinkSpices                            Category = "commercial.food_and_drink.spices"
+	CategoryCommercialFurnitureAndInterior                          Category = "commercial.furniture_and_interior"
+	CategoryCommercialFurnitureAndInteriorBathroom                  Category = "commercial.furniture_and_interior.bathroom"
+	CategoryCommercialFurnitureAndInteriorBed                       Category = "commercial.furniture_and_interior.bed"
+	CategoryCommercialFurnitureAndInteriorCarpet                    Category = "commercial.furniture_and_interior.carpet"
+	CategoryCommercialFurnitureAndInteriorCurtain                   Category = "commercial.furniture_and_interior.curtain"
+	CategoryCommercialFurnitureAndInteriorKitchen                   Category = "commercial.furniture_and_interior.kitchen"
+	CategoryCommercialFurnitureAndInteriorLighting                  Category = "commercial.furniture_and_interior.lighting"
+	CategoryCommercialGarden                                        Category = "commercial.garden"
+	CategoryCommercialGas                                           Category = "commercial.gas"
+	CategoryCommercialGiftAndSouvenir                               Category = "commercial.gift_and_souvenir"
+	CategoryCommercialHealthAndBeauty                               Category = "commercial.health_and_beauty"
+	CategoryCommercialHealthAndBeautyCosmetics                      Category = "commercial.health_and_beauty.cosmetics"
+	CategoryCommercialHealthAndBeautyHearingAids                    Category = "commercial.health_and_beauty.hearing_aids"
+	CategoryCommercialHealthAndBeautyHerbalist                      Category = "commercial.health_and_beauty.herbalist"
+	CategoryCommercialHealthAndBeautyMedicalSupply                  Category = "commercial.health_and_beauty.medical_supply"
+	CategoryCommercialHealthAndBeautyOptician                       Category = "commercial.health_and_beauty.optician"
+	CategoryCommercialHealthAndBeautyPharmacy                       Category = "commercial.health_and_beauty.pharmacy"
+	CategoryCommercialHealthAndBeautyWigs                           Category = "commercial.health_and_beauty.wigs"
+	CategoryCommercialHobby                                         Category = "commercial.hobby"
+	CategoryCommercialHobbyAnime                                    Category = "commercial.hobby.anime"
+	CategoryCommercialHobbyArt                                      Category = "commercial.hobby.art"
+	CategoryCommercialHobbyBrewing                                  Category = "commercial.hobby.brewing"
+	CategoryCommercialHobbyCollecting                               Category = "commercial.hobby.collecting"
+	CategoryCommercialHobbyGames                                    Category = "commercial.hobby.games"
+	CategoryCommercialHobbyModel                                    Category = "commercial.hobby.model"
+	CategoryCommercialHobbyMusic                                    Category = "commercial.hobby.music"
+	CategoryCommercialHobbyPhoto                                    Category = "commercial.hobby.photo"
+	CategoryCommercialHobbySewingAndKnitting                        Category = "commercial.hobby.sewing_and_knitting"
+	CategoryCommercialHousewareAndHardware                          Category = "commercial.houseware_and_hardware"
+	CategoryCommercialHousewareAndHardwareBuildingMaterials         Category = "commercial.houseware_and_hardware.building_materials"
+	CategoryCommercialHousewareAndHardwareBuildingMaterialsDoors    Category = "commercial.houseware_and_hardware.building_materials.doors"
+	CategoryCommercialHousewareAndHardwareBuildingMaterialsFlooring Category = "commercial.houseware_and_hardware.building_materials.flooring"
+	CategoryCommercialHousewareAndHardwareBuildingMaterialsGlaziery Category = "commercial.houseware_and_hardware.building_materials.glaziery"
+	CategoryCommercialHousewareAndHardwareBuildingMaterialsPaint    Category = "commercial.houseware_and_hardware.building_materials.paint"
+	CategoryCommercialHousewareAndHardwareBuildingMaterialsTiles    Category = "commercial.houseware_and_hardware.building_materials.tiles"
+	CategoryCommercialHousewareAndHardwareBuildingMaterialsWindows  Category = "commercial.houseware_and_hardware.building_materials.windows"
+	CategoryCommercialHousewareAndHardwareDoityourself              Category = "commercial.houseware_and_hardware.doityourself"
+	CategoryCommercialHousewareAndHardwareFireplace                 Category = "commercial.houseware_and_hardware.fireplace"
+	CategoryCommercialHousewareAndHardwareHardware                  Category = "commercial.houseware_and_hardware.hardware"
+	CategoryCommercialHousewareAndHardwareSwimmingPool              Category = "commercial.houseware_and_hardware.swimming_pool"
+	CategoryCommercialJewelry                                       Category = "commercial.jewelry"
+	CategoryCommercialKiosk                                         Category = "commercial.kiosk"
+	CategoryCommercialMarketplace                                   Category = "commercial.marketplace"
+	CategoryCommercialNewsagent                                     Category = "commercial.newsagent"
+	CategoryCommercialOutdoorAndSport                               Category = "commercial.outdoor_and_sport"
+	CategoryCommercialOutdoorAndSportBicycle                        Category = "commercial.outdoor_and_sport.bicycle"
+	CategoryCommercialOutdoorAndSportDiving                         Category = "commercial.outdoor_and_sport.diving"
+	CategoryCommercialOutdoorAndSportFishing                        Category = "commercial.outdoor_and_sport.fishing"
+	CategoryCommercialOutdoorAndSportGolf                           Category = "commercial.outdoor_and_sport.golf"
+	CategoryCommercialOutdoorAndSportHunting                        Category = "commercial.outdoor_and_sport.hunting"
+	CategoryCommercialOutdoorAndSportSki                            Category = "commercial.outdoor_and_sport.ski"
+	CategoryCommercialOutdoorAndSportWaterSports                    Category = "commercial.outdoor_and_sport.water_sports"
+	CategoryCommercialPet                                           Category = "commercial.pet"
+	CategoryCommercialSecondHand                                    Category = "commercial.second_hand"
+	CategoryCommercialShoppingMall                                  Category = "commercial.shopping_mall"
+	CategoryCommercialSmoking                                       Category = "commercial.smoking"
+	CategoryCommercialStationery                                    Category = "commercial.stationery"
+	CategoryCommercialSupermarket                                   Category = "commercial.supermarket"
+	CategoryCommercialTicketsAndLottery                             Category = "commercial.tickets_and_lottery"
+	CategoryCommercialTobacco                                       Category = "commercial.tobacco"
+	CategoryCommercialToyAndGame                                    Category = "commercial.toy_and_game"
+	CategoryCommercialTrade                                         Category = "commercial.trade"
+	CategoryCommercialVehicle                                       Category = "commercial.vehicle"
+	CategoryCommercialVideoAndMusic                                 Category = "commercial.video_and_music"
+	CategoryCommercialWatches                                       Category = "commercial.watches"
+	CategoryCommercialWeapons                                       Category = "commercial.weapons"
+
+	CategoryEducation               Category = "education"
+	CategoryEducationCollege        Category = "education.college"
+	CategoryEducationDrivingSchool  Category = "education.driving_school"
+	CategoryEducationLanguageSchool Category = "education.language_school"
+	CategoryEducationLibrary        Category = "education.library"
+	CategoryEducationMusicSchool    Category = "education.music_school"
+	CategoryEducationSchool         Category = "education.school"
+	CategoryEducationUniversity     Category = "education.university"
+
+	CategoryEntertainment                       Category = "entertainment"
+	CategoryEntertainmentActivityPark           Category = "entertainment.activity_park"
+	CategoryEntertainmentActivityParkClimbing   Category = "entertainment.activity_park.climbing"
+	CategoryEntertainmentActivityParkTrampoline Category = "entertainment.activity_park.trampoline"
+	CategoryEntertainmentAmusementArcade        Category = "entertainment.amusement_arcade"
+	CategoryEntertainmentAquarium               Category = "entertainment.aquarium"
+	CategoryEntertainmentBowlingAlley           Category = "entertainment.bowling_alley"
+	CategoryEntertainmentCinema                 Category = "entertainment.cinema"
+	CategoryEntertainmentCulture                Category = "entertainment.culture"
+	CategoryEntertainmentCultureArtsCentre      Category = "entertainment.culture.arts_centre"
+	CategoryEntertainmentCultureGallery         Category = "entertainment.culture.gallery"
+	CategoryEntertainmentCultureTheatre         Category = "entertainment.culture.theatre"
+	CategoryEntertainmentEscapeGame             Category = "entertainment.escape_game"
+	CategoryEntertainmentFlyingFox              Category = "entertainment.flying_fox"
+	CategoryEntertainmentMiniatureGolf          Category = "entertainment.miniature_golf"
+	CategoryEntertainmentMuseum                 Category = "entertainment.museum"
+	CategoryEntertainmentPlanetarium            Category = "entertainment.planetarium"
+	CategoryEntertainmentThemePark              Category = "entertainment.theme_park"
+	CategoryEntertainmentWaterPark              Category = "entertainment.water_park"
+	CategoryEntertainmentZoo                    Category = "entertainment.zoo"
+
+	CategoryHealthcare                               Category = "healthcare"
+	CategoryHealthcareClinicOrPraxis                 Category = "healthcare.clinic_or_praxis"
+	CategoryHealthcareClinicOrPraxisAllergology      Category = "healthcare.clinic_or_praxis.allergology"
+	CategoryHealthcareClinicOrPraxisCardiology       Category = "healthcare.clinic_or_praxis.cardiology"
+	CategoryHealthcareClinicOrPraxisDermatology      Category = "healthcare.clinic_or_praxis.dermatology"
+	CategoryHealthcareClinicOrPraxisEndocrinology    Category = "healthcare.clinic_or_praxis.endocrinology"
+	CategoryHealthcareClinicOrPraxisGastroenterology Category = "healthcare.clinic_or_praxis.gastroenterology"
+	CategoryHealthcareClinicOrPraxisGeneral          Category = "healthcare.clinic_or_praxis.general"
+	CategoryHealthcareClinicOrPraxisGynaecology      Category = "healthcare.clinic_or_praxis.gynaecology"
+	CategoryHealthcareClinicOrPraxisOccupational     Category = "healthcare.clinic_or_praxis.occupational"
+	CategoryHealthcareClinicOrPraxisOphthalmology    Category = "healthcare.clinic_or_praxis.ophthalmology"
+	CategoryHealthcareClinicOrPraxisOrthopaedics     Category = "healthcare.clinic_or_praxis.orthopaedics"
+	CategoryHealthcareClinicOrPraxisOtolaryngology   Category = "healthcare.clinic_or_praxis.otolaryngology"
+	CategoryHealthcareClinicOrPraxisPediatrics       Category = "healthcare.clinic_or_praxis.pediatrics"
+	CategoryHealthcareClinicOrPraxisPsychiatry       Category = "healthcare.clinic_or_praxis.psychiatry"
+	CategoryHealthcareClinicOrPraxisPulmonology      Category = "healthcare.clinic_or_praxis.pulmonology"
+	CategoryHealthcareClinicOrPraxisRadiology        Category = "healthcare.clinic_or_praxis.radiology"
+	CategoryHealthcareClinicOrPraxisTrauma           Category = "healthcare.clinic_or_praxis.trauma"
+	CategoryHealthcareClinicOrPraxisUrology          Category = "healthcare.clinic_or_praxis.urology"
+	CategoryHealthcareClinicOrPraxisVascularSurgery  Category = "healthcare.clinic_or_praxis.vascular_surgery"
+	CategoryHealthcareDentist                        Category = "healthcare.dentist"
+	CategoryHealthcareDentistOrthodontics            Category = "healthcare.dentist.orthodontics"
+	CategoryHealthcareHospital                       Category = "healthcare.hospital"
+	CategoryHealthcarePharmacy                       Category = "healthcare.pharmacy"
+
+	CategoryHeritage       Category = "heritage"
+	CategoryHeritageUnesco Category = "heritage.unesco"
+
+	CategoryHighway             Category = "highway"
+	CategoryHighwayBridleway    Category = "highway.bridleway"
+	CategoryHighwayCycleway     Category = "highway.cycleway"
+	CategoryHighwayFootway      Category = "highway.footway"
+	CategoryHighwayMotorway     Category = "highway.motorway"
+	CategoryHighwayPath         Category = "highway.path"
+	CategoryHighwayPedestrian   Category = "highway.pedestrian"
+	CategoryHighwayPrimary      Category = "highway.primary"
+	CategoryHighwayResidential  Category = "highway.residential"
+	CategoryHighwayRoad         Category = "highway.road"
+	CategoryHighwaySecondary    Category = "highway.secondary"
+	CategoryHighwayService      Category = "highway.service"
+	CategoryHighwaySteps        Category = "highway.steps"
+	CategoryHighwayTertiary     Category = "highway.tertiary"
+	CategoryHighwayTrack        Category = "highway.track"
+	CategoryHighwayTrunk        Category = "highway.trunk"
+	CategoryHighwayUnclassified Category = "highway.unclassified"
+
+	CategoryLeisure                  Category = "leisure"
+	CategoryLeisurePark              Category = "leisure.park"
+	CategoryLeisureParkGarden        Category = "leisure.park.garden"
+	CategoryLeisureParkNatureReserve Category = "leisure.park.nature_reserve"
+	CategoryLeisurePicnic            Category = "leisure.picnic"
+	CategoryLeisurePicnicBbq         Category = "leisure.picnic.bbq"
+	CategoryLeisurePicnicPicnicSite  Category = "leisure.picnic.picnic_site"
+	CategoryLeisurePicnicPicnicTable Category = "leisure.picnic.picnic_table"
+	CategoryLeisurePlayground        Category = "leisure.playground"
+	CategoryLeisureSpa               Category = "leisure.spa"
+	CategoryLeisureSpaPublicBath     Category = "leisure.spa.public_bath"
+	CategoryLeisureSpaSauna          Category = "leisure.spa.sauna"
+
+	CategoryLowEmissionZone Category = "low_emission_zone"
+
+	CategoryManMade           Category = "man_made"
+	CategoryManMadeBreakwater Category = "man_made.breakwater"
+	CategoryManMadeBridge     Category = "man_made.bridge"
+	CategoryManMadeLighthouse Category = "man_made.lighthouse"
+	CategoryManMadePier       Category = "man_made.pier"
+	CategoryManMadeTower      Category = "man_made.tower"
+	CategoryManMadeWaterTower Category = "man_made.water_tower"
+	CategoryManMadeWatermill  Category = "man_made.watermill"
+	CategoryManMadeWindmill   Category = "man_made.windmill"
+
+	CategoryNationalPark Category = "national_park"
+
+	CategoryNatural                     Category = "natural"
+	CategoryNaturalForest               Category = "natural.forest"
+	CategoryNaturalMountain             Category = "natural.mountain"
+	CategoryNaturalMountainCaveEntrance Category = "natural.mountain.cave_entrance"
+	CategoryNaturalMountainCliff        Category = "natural.mountain.cliff"
+	CategoryNaturalMountainGlacier      Category = "natural.mountain.glacier"
+	CategoryNaturalMountainPeak         Category = "natural.mountain.peak"
+	CategoryNaturalMountainRock         Category = "natural.mountain.rock"
+	CategoryNaturalProtectedArea        Category = "natural.protected_area"
+	CategoryNaturalSand                 Category = "natural.sand"
+	CategoryNaturalSandDune             Category = "natural.sand.dune"
+	CategoryNaturalWater                Category = "natural.water"
+	CategoryNaturalWaterGeyser          Category = "natural.water.geyser"
+	CategoryNaturalWaterHotSpring       Category = "natural.water.hot_spring"
+	CategoryNaturalWaterReef            Category = "natural.water.reef"
+	CategoryNaturalWaterSea             Category = "natural.water.sea"
+	CategoryNaturalWaterSpring          Category = "natural.water.spring"
+
+	CategoryOffice                         Category = "office"
+	CategoryOfficeAccountant               Category = "office.accountant"
+	CategoryOfficeAdvertisingAgency        Category = "office.advertising_agency"
+	CategoryOfficeArchitect                Category = "office.architect"
+	CategoryOfficeAssociation              Category = "office.association"
+	CategoryOfficeCharity                  Category = "office.charity"
+	CategoryOfficeCompany                  Category = "office.company"
+	CategoryOfficeConsulting               Category = "office.consulting"
+	CategoryOfficeCoworking                Category = "office.coworking"
+	CategoryOfficeDiplomatic               Category = "office.diplomatic"
+	CategoryOfficeEducationalInstitution   Category = "office.educational_institution"
+	CategoryOfficeEmploymentAgency         Category = "office.employment_agency"
+	CategoryOfficeEnergySupplier           Category = "office.energy_supplier"
+	CategoryOfficeEstateAgent              Category = "office.estate_agent"
+	CategoryOfficeFinancial                Category = "office.financial"
+	CategoryOfficeFinancialAdvisor         Category = "office.financial_advisor"
+	CategoryOfficeForestry                 Category = "office.forestry"
+	CategoryOfficeFoundation               Category = "office.foundation"
+	CategoryOfficeGovernment               Category = "office.government"
+	CategoryOfficeGovernmentAdministrative Category = "office.government.administrative"
+	CategoryOfficeGovernmentAgriculture    Category = "office.government.agriculture"
+	CategoryOfficeGovernmentCadaster       Category = "office.government.cadaster"
+	CategoryOfficeGovernmentCulture        Category = "office.government.culture"
+	CategoryOfficeGovernmentEducation      Category = "office.government.education"
+	CategoryOfficeGovernmentEnvironment    Category = "office.government.environment"
+	CategoryOfficeGovernmentForestry       Category = "office.government.forestry"
+	CategoryOfficeGovernmentHealthcare     Category = "office.government.healthcare"
+	CategoryOfficeGovernmentLegislative    Category = "office.government.legislative"
+	CategoryOfficeGovernmentMigration      Category = "office.government.migration"
+	CategoryOfficeGovernmentMinistry       Category = "office.government.ministry"
+	CategoryOfficeGovernmentProsecutor     Category = "office.government.prosecutor"
+	CategoryOfficeGovernmentPublicService  Category = "office.government.public_service"
+	CategoryOfficeGovernmentRegisterOffice Category = "office.government.register_office"
+	CategoryOfficeGovernmentSocialSecurity Category = "office.government.social_security"
+	CategoryOfficeGovernmentSocialServices Category = "office.government.social_services"
+	CategoryOfficeGovernmentTax            Category = "office.government.tax"
+	CategoryOfficeGovernmentTransportation Category = "office.government.transportation"
+	CategoryOfficeInsurance                Category = "office.insurance"
+	CategoryOfficeIt                       Category = "office.it"
+	CategoryOfficeLawyer                   Category = "office.lawyer"
+	CategoryOfficeLogistics                Category = "office.logistics"
+	CategoryOfficeNewspaper                Category = "office.newspaper"
+	CategoryOfficeNonProfit                Category = "office.non_profit"
+	CategoryOfficeNotary                   Category = "office.notary"
+	CategoryOfficePoliticalParty           Category = "office.political_party"
+	CategoryOfficeReligion                 Category = "office.religion"
+	CategoryOfficeResearch                 Category = "office.research"
+	CategoryOfficeSecurity                 Category = "office.security"
+	CategoryOfficeTaxAdvisor               Category = "office.tax_advisor"
+	CategoryOfficeTelecommunication        Category = "office.telecommunication"
+	CategoryOfficeTravelAgent              Category = "office.travel_agent"
+	CategoryOfficeWaterUtility             Category = "office.water_utility"
+
+	CategoryParking                Category = "parking"
+	CategoryParkingBicycles        Category = "parking.bicycles"
+	CategoryParkingCars            Category = "parking.cars"
+	CategoryParkingCarsMultistorey Category = "parking.cars.multistorey"
+	CategoryParkingCarsRooftop     Category = "parking.cars.rooftop"
+	CategoryParkingCarsSurface     Category = "parking.cars.surface"
+	CategoryParkingCarsUnderground Category = "parking.cars.underground"
+	CategoryParkingMotorcycle      Category = "parking.motorcycle"
+	CategoryParkingMultistorey     Category = "parking.multistorey"
+	CategoryParkingRooftop         Category = "parking.rooftop"
+	CategoryParkingSurface         Category = "parking.surface"
+	CategoryParkingUnderground     Category = "parking.underground"
+
+	CategoryPet           Category = "pet"
+	CategoryPetDogPark    Category = "pet.dog_park"
+	CategoryPetService    Category = "pet.service"
+	CategoryPetShop       Category = "pet.shop"
+	CategoryPetVeterinary Category = "pet.veterinary"
+
+	CategoryPolitical Category = "political"
+
+	CategoryPopulatedPlace              Category = "populated_place"
+	CategoryPopulatedPlaceAllotments    Category = "populated_place.allotments"
+	CategoryPopulatedPlaceBorough       Category = "populated_place.borough"
+	CategoryPopulatedPlaceCity          Category = "populated_place.city"
+	CategoryPopulatedPlaceCityBlock     Category = "populated_place.city_block"
+	CategoryPopulatedPlaceCounty        Category = "populated_place.county"
+	CategoryPopulatedPlaceDistrict      Category = "populated_place.district"
+	CategoryPopulatedPlaceHamlet        Category = "populated_place.hamlet"
+	CategoryPopulatedPlaceMunicipality  Category = "populated_place.municipality"
+	CategoryPopulatedPlaceNeighbourhood Category = "populated_place.neighbourhood"
+	CategoryPopulatedPlaceProvince      Category = "populated_place.province"
+	CategoryPopulatedPlaceQuarter       Category = "populated_place.quarter"
+	CategoryPopulatedPlaceRegion        Category = "populated_place.region"
+	CategoryPopulatedPlaceState         Category = "populated_place.state"
+	CategoryPopulatedPlaceSubdistrict   Category = "populated_place.subdistrict"
+	CategoryPopulatedPlaceSuburb        Category = "populated_place.suburb"
+	CategoryPopulatedPlaceTown          Category = "populated_place.town"
+	CategoryPopulatedPlaceTownship      Category = "populated_place.township"
+	CategoryPopulatedPlaceVillage       Category = "populated_place.village"
+
+	CategoryPostalCode Category = "postal_code"
+
+	CategoryPower            Category = "power"
+	CategoryPowerGenerator   Category = "power.generator"
+	CategoryPowerLine        Category = "power.line"
+	CategoryPowerMinorLine   Category = "power.minor_line"
+	CategoryPowerPlant       Category = "power.plant"
+	CategoryPowerPowerPlant  Category = "power.power_plant"
+	CategoryPowerSubstation  Category = "power.substation"
+	CategoryPowerTransformer Category = "power.transformer"
+
+	CategoryProduction        Category = "production"
+	CategoryProductionBrewery Category = "production.brewery"
+	CategoryProductionCheese  Category = "production.cheese"
+	CategoryProductionFactory Category = "production.factory"
+	CategoryProductionPottery Category = "production.pottery"
+	CategoryProductionWinery  Category = "production.winery"
+
+	CategoryPublicTransport               Category = "public_transport"
+	CategoryPublicTransportAerialway      Category = "public_transport.aerialway"
+	CategoryPublicTransportBus            Category = "public_transport.bus"
+	CategoryPublicTransportFerry          Category = "public_transport.ferry"
+	CategoryPublicTransportLightRail      Category = "public_transport.light_rail"
+	CategoryPublicTransportMonorail       Category = "public_transport.monorail"
+	CategoryPublicTransportSubway         Category = "public_transport.subway"
+	CategoryPublicTransportSubwayEntrance Category = "public_transport.subway.entrance"
+	CategoryPublicTransportTrain          Category = "public_transport.train"
+	CategoryPublicTransportTram           Category = "public_transport.tram"
+
+	CategoryRailway            Category = "railway"
+	CategoryRailwayFunicular   Category = "railway.funicular"
+	CategoryRailwayLightRail   Category = "railway.light_rail"
+	CategoryRailwayMiniature   Category = "railway.miniature"
+	CategoryRailwayMonorail    Category = "railway.monorail"
+	CategoryRailwayNarrowGauge Category = "railway.narrow_gauge"
+	CategoryRailwayPreserved   Category = "railway.preserved"
+	CategoryRailwaySubway      Category = "railway.subway"
+	CategoryRailwayTrain       Category = "railway.train"
+	CategoryRailwayTram        Category = "railway.tram"
+
+	CategoryReligion                           Category = "religion"
+	CategoryReligionPlaceOfWorship             Category = "religion.place_of_worship"
+	CategoryReligionPlaceOfWorshipBuddhism     Category = "religion.place_of_worship.buddhism"
+	CategoryReligionPlaceOfWorshipChristianity Category = "religion.place_of_worship.christianity"
+	CategoryReligionPlaceOfWorshipHinduism     Category = "religion.place_of_worship.hinduism"
+	CategoryReligionPlaceOfWorshipIslam        Category = "religion.place_of_worship.islam"
+	CategoryReligionPlaceOfWorshipJudaism      Category = "religion.place_of_worship.judaism"
+	CategoryReligionPlaceOfWorshipMultifaith   Category = "religion.place_of_worship.multifaith"
+	CategoryReligionPlaceOfWorshipShinto       Category = "religion.place_of_worship.shinto"
+	CategoryReligionPlaceOfWorshipSikhism      Category = "religion.place_of_worship.sikhism"
+
+	CategoryRental        Category = "rental"
+	CategoryRentalBicycle Category = "rental.bicycle"
+	CategoryRentalBoat    Category = "rental.boat"
+	CategoryRentalCar     Category = "rental.car"
+	CategoryRentalSki     Category = "rental.ski"
+	CategoryRentalStorage Category = "rental.storage"
+
+	CategoryService                         Category = "service"
+	CategoryServiceBeauty                   Category = "service.beauty"
+	CategoryServiceBeautyHairdresser        Category = "service.beauty.hairdresser"
+	CategoryServiceBeautyMassage            Category = "service.beauty.massage"
+	CategoryServiceBeautySpa                Category = "service.beauty.spa"
+	CategoryServiceCleaning                 Category = "service.cleaning"
+	CategoryServiceCleaningDryCleaning      Category = "service.cleaning.dry_cleaning"
+	CategoryServiceCleaningLaundry          Category = "service.cleaning.laundry"
+	CategoryServiceCleaningLavoir           Category = "service.cleaning.lavoir"
+	CategoryServiceFinancial                Category = "service.financial"
+	CategoryServiceFinancialAtm             Category = "service.financial.atm"
+	CategoryServiceFinancialBank            Category = "service.financial.bank"
+	CategoryServiceFinancialBureauDeChange  Category = "service.financial.bureau_de_change"
+	CategoryServiceFinancialMoneyLender     Category = "service.financial.money_lender"
+	CategoryServiceFinancialMoneyTransfer   Category = "service.financial.money_transfer"
+	CategoryServiceFinancialPaymentTerminal Category = "service.financial.payment_terminal"
+	CategoryServiceFireStation              Category = "service.fire_station"
+	CategoryServiceFuneralDirectors         Category = "service.funeral_directors"
+	CategoryServicePolice                   Category = "service.police"
+	CategoryServicePost                     Category = "service.post"
+	CategoryServicePostBox                  Category = "service.post.box"
+	CategoryServicePostOffice               Category = "service.post.office"
+	CategoryServiceSocialFacility           Category = "service.social_facility"
+	CategoryServiceTaxi                     Category = "service.taxi"
+	CategoryServiceTravelAgency             Category = "service.travel_agency"
+	CategoryServiceVehicle                  Category = "service.vehicle"
+	CategoryServiceVehicleCarWash           Category = "service.vehicle.car_wash"
+	CategoryServiceVehicleChargingStation   Category = "service.vehicle.charging_station"
+	CategoryServiceVehicleFuel              Category = "service.vehicle.fuel"
+	CategoryServiceVehicleParts             Category = "service.vehicle.parts"
+	CategoryServiceVehicleRepair            Category = "service.vehicle.repair"
+	CategoryServiceVehicleRepairCar         Category = "service.vehicle.repair.car"
+	CategoryServiceVehicleRepairMotorcycle  Category = "service.vehicle.repair.motorcycle"
+
+	CategorySki                Category = "ski"
+	CategorySkiLift            Category = "ski.lift"
+	CategorySkiLiftCableCar    Category = "ski.lift.cable_car"
+	CategorySkiLiftChairLift   Category = "ski.lift.chair_lift"
+	CategorySkiLiftGondola     Category = "ski.lift.gondola"
+	CategorySkiLiftMagicCarpet Category = "ski.lift.magic_carpet"
+	CategorySkiLiftMixedLift   Category = "ski.lift.mixed_lift"
+	CategorySkiLiftTowLine     Category = "ski.lift.tow_line"
+	CategorySkiRental          Category = "ski.rental"
+	CategorySkiSchool          Category = "ski.school"
+
+	CategorySport                      Category = "sport"
+	CategorySportDiveCentre            Category = "sport.dive_centre"
+	CategorySportFitness               Category = "sport.fitness"
+	CategorySportFitnessFitnessCentre  Category = "sport.fitness.fitness_centre"
+	CategorySportFitnessFitnessStation Category = "sport.fitness.fitness_station"
+	CategorySportHorseRiding           Category = "sport.horse_riding"
+	CategorySportIceRink               Category = "sport.ice_rink"
+	CategorySportPitch                 Category = "sport.pitch"
+	CategorySportSportsCentre          Category = "sport.sports_centre"
+	CategorySportStadium               Category = "sport.stadium"
+	CategorySportSwimmingPool          Category = "sport.swimming_pool"
+	CategorySportTrack                 Category = "sport.track"
+
+	CategoryTourism                              Category = "tourism"
+	CategoryTourismAttraction                    Category = "tourism.attraction"
+	CategoryTourismAttractionArtwork             Category = "tourism.attraction.artwork"
+	CategoryTourismAttractionClock               Category = "tourism.attraction.clock"
+	CategoryTourismAttractionFountain            Category = "tourism.attraction.fountain"
+	CategoryTourismAttractionViewpoint           Category = "tourism.attraction.viewpoint"
+	CategoryTourismInformation                   Category = "tourism.information"
+	CategoryTourismInformationMap                Category = "tourism.information.map"
+	CategoryTourismInformationOffice             Category = "tourism.information.office"
+	CategoryTourismInformationRangerStation      Category = "tourism.information.ranger_station"
+	CategoryTourismSights                        Category = "tourism.sights"
+	CategoryTourismSightsArchaeologicalSite      Category = "tourism.sights.archaeological_site"
+	CategoryTourismSightsBattlefield             Category = "tourism.sights.battlefield"
+	CategoryTourismSightsBridge                  Category = "tourism.sights.bridge"
+	CategoryTourismSightsCastle                  Category = "tourism.sights.castle"
+	CategoryTourismSightsCityGate                Category = "tourism.sights.city_gate"
+	CategoryTourismSightsCityHall                Category = "tourism.sights.city_hall"
+	CategoryTourismSightsConferenceCenter        Category = "tourism.sights.conference_center"
+	CategoryTourismSightsFort                    Category = "tourism.sights.fort"
+	CategoryTourismSightsLighthouse              Category = "tourism.sights.lighthouse"
+	CategoryTourismSightsMemorial                Category = "tourism.sights.memorial"
+	CategoryTourismSightsMemorialAircraft        Category = "tourism.sights.memorial.aircraft"
+	CategoryTourismSightsMemorialBoundaryStone   Category = "tourism.sights.memorial.boundary_stone"
+	CategoryTourismSightsMemorialCross           Category = "tourism.sights.memorial.cross"
+	CategoryTourismSightsMemorialMilestone       Category = "tourism.sights.memorial.milestone"
+	CategoryTourismSightsMemorialMonument        Category = "tourism.sights.memorial.monument"
+	CategoryTourismSightsMemorialPlaque          Category = "tourism.sights.memorial.plaque"
+	CategoryTourismSightsMemorialShip            Category = "tourism.sights.memorial.ship"
+	CategoryTourismSightsMemorialTank            Category = "tourism.sights.memorial.tank"
+	CategoryTourismSightsMemorialTomb            Category = "tourism.sights.memorial.tomb"
+	CategoryTourismSightsMemorialWaysideCross    Category = "tourism.sights.memorial.wayside_cross"
+	CategoryTourismSightsMonastery               Category = "tourism.sights.monastery"
+	CategoryTourismSightsPlaceOfWorship          Category = "tourism.sights.place_of_worship"
+	CategoryTourismSightsPlaceOfWorshipCathedral Category = "tourism.sights.place_of_worship.cathedral"
+	CategoryTourismSightsPlaceOfWorshipChapel    Category = "tourism.sights.place_of_worship.chapel"
+	CategoryTourismSightsPlaceOfWorshipChurch    Category = "tourism.sights.place_of_worship.church"
+	CategoryTourismSightsPlaceOfWorshipMosque    Category = "tourism.sights.place_of_worship.mosque"
+	CategoryTourismSightsPlaceOfWorshipShrine    Category = "tourism.sights.place_of_worship.shrine"
+	CategoryTourismSightsPlaceOfWorshipSynagogue Category = "tourism.sights.place_of_worship.synagogue"
+	CategoryTourismSightsPlaceOfWorshipTemple    Category = "tourism.sights.place_of_worship.temple"
+	CategoryTourismSightsRuines                  Category = "tourism.sights.ruines"
+	CategoryTourismSightsTower                   Category = "tourism.sights.tower"
+	CategoryTourismSightsWindmill                Category = "tourism.sights.windmill"
+)
+
+// knownCategories lists every category of the Places API, sorted.
+var knownCategories = []Category{
+	CategoryAccommodation,
+	CategoryAccommodationApartment,
+	CategoryAccommodationChalet,
+	CategoryAccommodationGuestHouse,
+	CategoryAccommodationHostel,
+	CategoryAccommodationHotel,
+	CategoryAccommodationHut,
+	CategoryAccommodationMotel,
+	CategoryActivity,
+	CategoryActivityCommunityCenter,
+	CategoryActivitySportClub,
+	CategoryAdministrative,
+	CategoryAdministrativeCityLevel,
+	CategoryAdministrativeContinentLevel,
+	CategoryAdministrativeCountryLevel,
+	CategoryAdministrativeCountryPartLevel,
+	CategoryAdministrativeCountyLevel,
+	CategoryAdministrativeDistrictLevel,
+	CategoryAdministrativeNeighbourhoodLevel,
+	CategoryAdministrativeStateLevel,
+	CategoryAdministrativeSuburbLevel,
+	CategoryAdult,
+	CategoryAdultAdultGamingCentre,
+	CategoryAdultCasino,
+	CategoryAdultNightclub,
+	CategoryAdultStripclub,
+	CategoryAdultSwingerclub,
+	CategoryAirport,
+	CategoryAirportInternational,
+	CategoryAmenity,
+	CategoryAmenityDrinkingWater,
+	CategoryAmenityGiveBox,
+	CategoryAmenityToilet,
+	CategoryBeach,
+	CategoryBeachBeachResort,
+	CategoryBuilding,
+	CategoryBuildingAccommodation,
+	CategoryBuildingCatering,
+	CategoryBuildingCollege,
+	CategoryBuildingCommercial,
+	CategoryBuildingDormitory,
+	CategoryBuildingDrivingSchool,
+	CategoryBuildingEntertainment,
+	CategoryBuildingFacility,
+	CategoryBuildingGarage,
+	CategoryBuildingHealthcare,
+	CategoryBuildingHistoric,
+	CategoryBuildingHolidayHouse,
+	CategoryBuildingIndustrial,
+	CategoryBuildingKindergarten,
+	CategoryBuildingMilitary,
+	CategoryBuildingOffice,
+	CategoryBuildingParking,
+	CategoryBuildingPlaceOfWorship,
+	CategoryBuildingPrison,
+	CategoryBuildingPublicAndCivil,
+	CategoryBuildingResidential,
+	CategoryBuildingSchool,
+	CategoryBuildingService,
+	CategoryBuildingSpa,
+	CategoryBuildingSport,
+	CategoryBuildingToilet,
+	CategoryBuildingTourism,
+	CategoryBuildingTransportation,
+	CategoryBuildingUniversity,
+	CategoryCamping,
+	CategoryCampingCampPitch,
+	CategoryCampingCampSite,
+	CategoryCampingCaravanSite,
+	CategoryCampingSummerCamp,
+	CategoryCatering,
+	CategoryCateringBar,
+	CategoryCateringBiergarten,
+	CategoryCateringCafe,
+	CategoryCateringCafeBubbleTea,
+	CategoryCateringCafeCake,
+	CategoryCateringCafeCoffee,
+	CategoryCateringCafeCoffeeShop,
+	CategoryCateringCafeCrepe,
+	CategoryCateringCafeDessert,
+	CategoryCateringCafeDonut,
+	CategoryCateringCafeFrozenYogurt,
+	CategoryCateringCafeIceCream,
+	CategoryCateringCafeTea,
+	CategoryCateringCafeWaffle,
+	CategoryCateringFastFood,
+	CategoryCateringFastFoodBurger,
+	CategoryCateringFastFoodChicken,
+	CategoryCateringFastFoodFishAndChips,
+	CategoryCateringFastFoodHotDog,
+	CategoryCateringFastFoodKebab,
+	CategoryCateringFastFoodNoodle,
+	CategoryCateringFastFoodPizza,
+	CategoryCateringFastFoodRamen,
+	CategoryCateringFastFoodSalad,
+	CategoryCateringFastFoodSandwich,
+	CategoryCateringFastFoodSoup,
+	CategoryCateringFastFoodTacos,
+	CategoryCateringFastFoodTapas,
+	CategoryCateringFastFoodWings,
+	CategoryCateringFoodCourt,
+	CategoryCateringIceCream,
+	CategoryCateringPub,
+	CategoryCateringRestaurant,
+	CategoryCateringRestaurantAfghan,
+	CategoryCateringRestaurantAfrican,
+	CategoryCateringRestaurantAmerican,
+	CategoryCateringRestaurantArab,
+	CategoryCateringRestaurantArgentinian,
+	CategoryCateringRestaurantAsian,
+	CategoryCateringRestaurantAustrian,
+	CategoryCateringRestaurantBalkan,
+	CategoryCateringRestaurantBarbecue,
+	CategoryCateringRestaurantBavarian,
+	CategoryCateringRestaurantBeefBowl,
+	CategoryCateringRestaurantBelgian,
+	CategoryCateringRestaurantBolivian,
+	CategoryCateringRestaurantBrazilian,
+	CategoryCateringRestaurantBurger,
+	CategoryCateringRestaurantCaribbean,
+	CategoryCateringRestaurantChicken,
+	CategoryCateringRestaurantChili,
+	CategoryCateringRestaurantChinese,
+	CategoryCateringRestaurantCroatian,
+	CategoryCateringRestaurantCuban,
+	CategoryCateringRestaurantCurry,
+	CategoryCateringRestaurantCzech,
+	CategoryCateringRestaurantDanish,
+	CategoryCateringRestaurantDumpling,
+	CategoryCateringRestaurantEthiopian,
+	CategoryCateringRestaurantEuropean,
+	CategoryCateringRestaurantFilipino,
+	CategoryCateringRestaurantFish,
+	CategoryCateringRestaurantFishAndChips,
+	CategoryCateringRestaurantFrench,
+	CategoryCateringRestaurantFriture,
+	CategoryCateringRestaurantGeorgian,
+	CategoryCateringRestaurantGerman,
+	CategoryCateringRestaurantGreek,
+	CategoryCateringRestaurantHawaiian,
+	CategoryCateringRestaurantHungarian,
+	CategoryCateringRestaurantIndian,
+	CategoryCateringRestaurantIndonesian,
+	CategoryCateringRestaurantInternational,
+	CategoryCateringRestaurantIrish,
+	CategoryCateringRestaurantItalian,
+	CategoryCateringRestaurantJamaican,
+	CategoryCateringRestaurantJapanese,
+	CategoryCateringRestaurantKebab,
+	CategoryCateringRestaurantKorean,
+	CategoryCateringRestaurantLatinAmerican,
+	CategoryCateringRestaurantLebanese,
+	CategoryCateringRestaurantMalay,
+	CategoryCateringRestaurantMalaysian,
+	CategoryCateringRestaurantMediterranean,
+	CategoryCateringRestaurantMexican,
+	CategoryCateringRestaurantMoroccan,
+	CategoryCateringRestaurantNepalese,
+	CategoryCateringRestaurantNoodle,
+	CategoryCateringRestaurantOriental,
+	CategoryCateringRestaurantPakistani,
+	CategoryCateringRestaurantPersian,
+	CategoryCateringRestaurantPeruvian,
+	CategoryCateringRestaurantPita,
+	CategoryCateringRestaurantPizza,
+	CategoryCateringRestaurantPortuguese,
+	CategoryCateringRestaurantRamen,
+	CategoryCateringRestaurantRegional,
+	CategoryCateringRestaurantRussian,
+	CategoryCateringRestaurantSandwich,
+	CategoryCateringRestaurantSeafood,
+	CategoryCateringRestaurantSoup,
+	CategoryCateringRestaurantSpanish,
+	CategoryCateringRestaurantSteakHouse,
+	CategoryCateringRestaurantSushi,
+	CategoryCateringRestaurantSwedish,
+	CategoryCateringRestaurantSyrian,
+	CategoryCateringRestaurantTacos,
+	CategoryCateringRestaurantTaiwanese,
+	CategoryCateringRestaurantTapas,
+	CategoryCateringRestaurantTexMex,
+	CategoryCateringRestaurantThai,
+	CategoryCateringRestaurantTurkish,
+	CategoryCateringRestaurantUkrainian,
+	CategoryCateringRestaurantUzbek,
+	CategoryCateringRestaurantVietnamese,
+	CategoryCateringRestaurantWestern,
+	CategoryCateringRestaurantWings,
+	CategoryCateringTaproom,
+	CategoryChildcare,
+	CategoryChildcareKindergarten,
+	CategoryCommercial,
+	CategoryCommercialAgrarian,
+	CategoryCommercialAntiques,
+	CategoryCommercialArt,
+	CategoryCommercialBabyGoods,
+	CategoryCommercialBag,
+	CategoryCommercialBooks,
+	CategoryCommercialChemist,
+	CategoryCommercialClothing,
+	CategoryCommercialClothingAccessories,
+	CategoryCommercialClothingClothes,
+	CategoryCommercialClothingKids,
+	CategoryCommercialClothingMen,
+	CategoryCommercialClothingShoes,
+	CategoryCommercialClothingSport,
+	CategoryCommercialClothingUnderwear,
+	CategoryCommercialClothingWomen,
+	CategoryCommercialConvenience,
+	CategoryCommercialDepartmentStore,
+	CategoryCommercialDiscountStore,
+	CategoryCommercialElektronics,
+	CategoryCommercialEnergy,
+	CategoryCommercialErotic,
+	CategoryCommercialFlorist,
+	CategoryCommercialFoodAndDrink,
+	CategoryCommercialFoodAndDrinkBakery,
+	CategoryCommercialFoodAndDrinkButcher,
+	CategoryCommercialFoodAndDrinkCheeseAndDairy,
+	CategoryCommercialFoodAndDrinkChocolate,
+	CategoryCommercialFoodAndDrinkCoffeeAndTea,
+	CategoryCommercialFoodAndDrinkConfectionery,
+	CategoryCommercialFoodAndDrinkDeli,
+	CategoryCommercialFoodAndDrinkDrinks,
+	CategoryCommercialFoodAndDrinkFarm,
+	CategoryCommercialFoodAndDrinkFrozenFood,
+	CategoryCommercialFoodAndDrinkFruitAndVegetable,
+	CategoryCommercialFoodAndDrinkHealthFood,
+	CategoryCommercialFoodAndDrinkHoney,
+	CategoryCommercialFoodAndDrinkIceCream,
+	CategoryCommercialFoodAndDrinkNuts,
+	CategoryCommercialFoodAndDrinkOrganic,
+	CategoryCommercialFoodAndDrinkPasta,
+	CategoryCommercialFoodAndDrinkRice,
+	CategoryCommercialFoodAndDrinkSeafood,
+	CategoryCommercialFoodAndDrinkSpices,
+	CategoryCommercialFurnitureAndInterior,
+	CategoryCommercialFurnitureAndInteriorBathroom,
+	CategoryCommercialFurnitureAndInteriorBed,
+	CategoryCommercialFurnitureAndInteriorCarpet,
+	CategoryCommercialFurnitureAndInteriorCurtain,
+	CategoryCommercialFurnitureAndInteriorKitchen,
+	CategoryCommercialFurnitureAndInteriorLighting,
+	CategoryCommercialGarden,
+	CategoryCommercialGas,
+	CategoryCommercialGiftAndSouvenir,
+	CategoryCommercialHealthAndBeauty,
+	CategoryCommercialHealthAndBeautyCosmetics,
+	CategoryCommercialHealthAndBeautyHearingAids,
+	CategoryCommercialHealthAndBeautyHerbalist,
+	CategoryCommercialHealthAndBeautyMedicalSupply,
+	CategoryCommercialHealthAndBeautyOptician,
+	CategoryCommercialHealthAndBeautyPharmacy,
+	CategoryCommercialHealthAndBeautyWigs,
+	CategoryCommercialHobby,
+	CategoryCommercialHobbyAnime,
+	CategoryCommercialHobbyArt,
+	CategoryCommercialHobbyBrewing,
+	CategoryCommercialHobbyCollecting,
+	CategoryCommercialHobbyGames,
+	CategoryCommercialHobbyModel,
+	CategoryCommercialHobbyMusic,
+	CategoryCommercialHobbyPhoto,
+	CategoryCommercialHobbySewingAndKnitting,
+	CategoryCommercialHousewareAndHardware,
+	CategoryCommercialHousewareAndHardwareBuildingMaterials,
+	CategoryCommercialHousewareAndHardwareBuildingMaterialsDoors,
+	CategoryCommercialHousewareAndHardwareBuildingMaterialsFlooring,
+	CategoryCommercialHousewareAndHardwareBuildingMaterialsGlaziery,
+	CategoryCommercialHousewareAndHardwareBuildingMaterialsPaint,
+	CategoryCommercialHousewareAndHardwareBuildingMaterialsTiles,
+	CategoryCommercialHousewareAndHardwareBuildingMaterialsWindows,
+	CategoryCommercialHousewareAndHardwareDoityourself,
+	CategoryCommercialHousewareAndHardwareFireplace,
+	CategoryCommercialHousewareAndHardwareHardware,
+	CategoryCommercialHousewareAndHardwareSwimmingPool,
+	CategoryCommercialJewelry,
+	CategoryCommercialKiosk,
+	CategoryCommercialMarketplace,
+	CategoryCommercialNewsagent,
+	CategoryCommercialOutdoorAndSport,
+	CategoryCommercialOutdoorAndSportBicycle,
+	CategoryCommercialOutdoorAndSportDiving,
+	CategoryCommercialOutdoorAndSportFishing,
+	CategoryCommercialOutdoorAndSportGolf,
+	CategoryCommercialOutdoorAndSportHunting,
+	CategoryCommercialOutdoorAndSportSki,
+	CategoryCommercialOutdoorAndSportWaterSports,
+	CategoryCommercialPet,
+	CategoryCommercialSecondHand,
+	CategoryCommercialShoppingMall,
+	CategoryCommercialSmoking,
+	CategoryCommercialStationery,
+	CategoryCommercialSupermarket,
+	CategoryCommercialTicketsAndLottery,
+	CategoryCommercialTobacco,
+	CategoryCommercialToyAndGame,
+	CategoryCommercialTrade,
+	CategoryCommercialVehicle,
+	CategoryCommercialVideoAndMusic,
+	CategoryCommercialWatches,
+	CategoryCommercialWeapons,
+	CategoryEducation,
+	CategoryEducationCollege,
+	CategoryEducationDrivingSchool,
+	CategoryEducationLanguageSchool,
+	CategoryEducationLibrary,
+	CategoryEducationMusicSchool,
+	CategoryEducationSchool,
+	CategoryEducationUniversity,
+	CategoryEntertainment,
+	CategoryEntertainmentActivityPark,
+	CategoryEntertainmentActivityParkClimbing,
+	CategoryEntertainmentActivityParkTrampoline,
+	CategoryEntertainmentAmusementArcade,
+	CategoryEntertainmentAquarium,
+	CategoryEntertainmentBowlingAlley,
+	CategoryEntertainmentCinema,
+	CategoryEntertainmentCulture,
+	CategoryEntertainmentCultureArtsCentre,
+	CategoryEntertainmentCultureGallery,
+	CategoryEntertainmentCultureTheatre,
+	CategoryEntertainmentEscapeGame,
+	CategoryEntertainmentFlyingFox,
+	CategoryEntertainmentMiniatureGolf,
+	CategoryEntertainmentMuseum,
+	CategoryEntertainmentPlanetarium,
+	CategoryEntertainmentThemePark,
+	CategoryEntertainmentWaterPark,
+	CategoryEntertainmentZoo,
+	CategoryHealthcare,
+	CategoryHealthcareClinicOrPraxis,
+	CategoryHealthcareClinicOrPraxisAllergology,
+	CategoryHealthcareClinicOrPraxisCardiology,
+	CategoryHealthcareClinicOrPraxisDermatology,
+	CategoryHealthcareClinicOrPraxisEndocrinology,
+	CategoryHealthcareClinicOrPraxisGastroenterology,
+	CategoryHealthcareClinicOrPraxisGeneral,
+	CategoryHealthcareClinicOrPraxisGynaecology,
+	CategoryHealthcareClinicOrPraxisOccupational,
+	CategoryHealthcareClinicOrPraxisOphthalmology,
+	CategoryHealthcareClinicOrPraxisOrthopaedics,
+	CategoryHealthcareClinicOrPraxisOtolaryngology,
+	CategoryHealthcareClinicOrPraxisPediatrics,
+	CategoryHealthcareClinicOrPraxisPsychiatry,
+	CategoryHealthcareClinicOrPraxisPulmonology,
+	CategoryHealthcareClinicOrPraxisRadiology,
+	CategoryHealthcareClinicOrPraxisTrauma,
+	CategoryHealthcareClinicOrPraxisUrology,
+	CategoryHealthcareClinicOrPraxisVascularSurgery,
+	CategoryHealthcareDentist,
+	CategoryHealthcareDentistOrthodontics,
+	CategoryHealthcareHospital,
+	CategoryHealthcarePharmacy,
+	CategoryHeritage,
+	CategoryHeritageUnesco,
+	CategoryHighway,
+	CategoryHighwayBridleway,
+	CategoryHighwayCycleway,
+	CategoryHighwayFootway,
+	CategoryHighwayMotorway,
+	CategoryHighwayPath,
+	CategoryHighwayPedestrian,
+	CategoryHighwayPrimary,
+	CategoryHighwayResidential,
+	CategoryHighwayRoad,
+	CategoryHighwaySecondary,
+	CategoryHighwayService,
+	CategoryHighwaySteps,
+	CategoryHighwayTertiary,
+	CategoryHighwayTrack,
+	CategoryHighwayTrunk,
+	CategoryHighwayUnclassified,
+	CategoryLeisure,
+	CategoryLeisurePark,
+	CategoryLeisureParkGarden,
+	CategoryLeisureParkNatureReserve,
+	CategoryLeisurePicnic,
+	CategoryLeisurePicnicBbq,
+	CategoryLeisurePicnicPicnicSite,
+	CategoryLeisurePicnicPicnicTable,
+	CategoryLeisurePlayground,
+	CategoryLeisureSpa,
+	CategoryLeisureSpaPublicBath,
+	CategoryLeisureSpaSauna,
+	CategoryLowEmissionZone,
+	CategoryManMade,
+	CategoryManMadeBreakwater,
+	CategoryManMadeBridge,
+	CategoryManMadeLighthouse,
+	CategoryManMadePier,
+	CategoryManMadeTower,
+	CategoryManMadeWaterTower,
+	CategoryManMadeWatermill,
+	CategoryManMadeWindmill,
+	CategoryNationalPark,
+	CategoryNatural,
+	CategoryNaturalForest,
+	CategoryNaturalMountain,
+	CategoryNaturalMountainCaveEntrance,
+	CategoryNaturalMountainCliff,
+	CategoryNaturalMountainGlacier,
+	CategoryNaturalMountainPeak,
+	CategoryNaturalMountainRock,
+	CategoryNaturalProtectedArea,
+	CategoryNaturalSand,
+	CategoryNaturalSandDune,
+	CategoryNaturalWater,
+	CategoryNaturalWaterGeyser,
+	CategoryNaturalWaterHotSpring,
+	CategoryNaturalWaterReef,
+	CategoryNaturalWaterSea,
+	CategoryNaturalWaterSpring,
+	CategoryOffice,
+	CategoryOfficeAccountant,
+	CategoryOfficeAdvertisingAgency,
+	CategoryOfficeArchitect,
+	CategoryOfficeAssociation,
+	CategoryOfficeCharity,
+	CategoryOfficeCompany,
+	CategoryOfficeConsulting,
+	CategoryOfficeCoworking,
+	CategoryOfficeDiplomatic,
+	CategoryOfficeEducationalInstitution,
+	CategoryOfficeEmploymentAgency,
+	CategoryOfficeEnergySupplier,
+	CategoryOfficeEstateAgent,
+	CategoryOfficeFinancial,
+	CategoryOfficeFinancialAdvisor,
+	CategoryOfficeForestry,
+	CategoryOfficeFoundation,
+	CategoryOfficeGovernment,
+	CategoryOfficeGovernmentAdministrative,
+	CategoryOfficeGovernmentAgriculture,
+	CategoryOfficeGovernmentCadaster,
+	CategoryOfficeGovernmentCulture,
+	CategoryOfficeGovernmentEducation,
+	CategoryOfficeGovernmentEnvironment,
+	CategoryOfficeGovernmentForestry,
+	CategoryOfficeGovernmentHealthcare,
+	CategoryOfficeGovernmentLegislative,
+	CategoryOfficeGovernmentMigration,
+	CategoryOfficeGovernmentMinistry,
+	CategoryOfficeGovernmentProsecutor,
+	CategoryOfficeGovernmentPublicService,
+	CategoryOfficeGovernmentRegisterOffice,
+	CategoryOfficeGovernmentSocialSecurity,
+	CategoryOfficeGovernmentSocialServices,
+	CategoryOfficeGovernmentTax,
+	CategoryOfficeGovernmentTransportation,
+	CategoryOfficeInsurance,
+	CategoryOfficeIt,
+	CategoryOfficeLawyer,
+	CategoryOfficeLogistics,
+	CategoryOfficeNewspaper,
+	CategoryOfficeNonProfit,
+	CategoryOfficeNotary,
+	CategoryOfficePoliticalParty,
+	CategoryOfficeReligion,
+	CategoryOfficeResearch,
+	CategoryOfficeSecurity,
+	CategoryOfficeTaxAdvisor,
+	CategoryOfficeTelecommunication,
+	CategoryOfficeTravelAgent,
+	CategoryOfficeWaterUtility,
+	CategoryParking,
+	CategoryParkingBicycles,
+	CategoryParkingCars,
+	CategoryParkingCarsMultistorey,
+	CategoryParkingCarsRooftop,
+	CategoryParkingCarsSurface,
+	CategoryParkingCarsUnderground,
+	CategoryParkingMotorcycle,
+	CategoryParkingMultistorey,
+	CategoryParkingRooftop,
+	CategoryParkingSurface,
+	CategoryParkingUnderground,
+	CategoryPet,
+	CategoryPetDogPark,
+	CategoryPetService,
+	CategoryPetShop,
+	CategoryPetVeterinary,
+	CategoryPolitical,
+	CategoryPopulatedPlace,
+	CategoryPopulatedPlaceAllotments,
+	CategoryPopulatedPlaceBorough,
+	CategoryPopulatedPlaceCity,
+	CategoryPopulatedPlaceCityBlock,
+	CategoryPopulatedPlaceCounty,
+	CategoryPopulatedPlaceDistrict,
+	CategoryPopulatedPlaceHamlet,
+	CategoryPopulatedPlaceMunicipality,
+	CategoryPopulatedPlaceNeighbourhood,
+	CategoryPopulatedPlaceProvince,
+	CategoryPopulatedPlaceQuarter,
+	CategoryPopulatedPlaceRegion,
+	CategoryPopulatedPlaceState,
+	CategoryPopulatedPlaceSubdistrict,
+	CategoryPopulatedPlaceSuburb,
+	CategoryPopulatedPlaceTown,
+	CategoryPopulatedPlaceTownship,
+	CategoryPopulatedPlaceVillage,
+	CategoryPostalCode,
+	CategoryPower,
+	CategoryPowerGenerator,
+	CategoryPowerLine,
+	CategoryPowerMinorLine,
+	CategoryPowerPlant,
+	CategoryPowerPowerPlant,
+	CategoryPowerSubstation,
+	CategoryPowerTransformer,
+	CategoryProduction,
+	CategoryProductionBrewery,
+	CategoryProductionCheese,
+	CategoryProductionFactory,
+	CategoryProductionPottery,
+	CategoryProductionWinery,
+	CategoryPublicTransport,
+	CategoryPublicTransportAerialway,
+	CategoryPublicTransportBus,
+	CategoryPublicTransportFerry,
+	CategoryPublicTransportLightRail,
+	CategoryPublicTransportMonorail,
+	CategoryPublicTransportSubway,
+	CategoryPublicTransportSubwayEntrance,
+	CategoryPublicTransportTrain,
+	CategoryPublicTransportTram,
+	CategoryRailway,
+	CategoryRailwayFunicular,
+	CategoryRailwayLightRail,
+	CategoryRailwayMiniature,
+	CategoryRailwayMonorail,
+	CategoryRailwayNarrowGauge,
+	CategoryRailwayPreserved,
+	CategoryRailwaySubway,
+	CategoryRailwayTrain,
+	CategoryRailwayTram,
+	CategoryReligion,
+	CategoryReligionPlaceOfWorship,
+	CategoryReligionPlaceOfWorshipBuddhism,
+	CategoryReligionPlaceOfWorshipChristianity,
+	CategoryReligionPlaceOfWorshipHinduism,
+	CategoryReligionPlaceOfWorshipIslam,
+	CategoryReligionPlaceOfWorshipJudaism,
+	CategoryReligionPlaceOfWorshipMultifaith,
+	CategoryReligionPlaceOfWorshipShinto,
+	CategoryReligionPlaceOfWorshipSikhism,
+	CategoryRental,
+	CategoryRentalBicycle,
+	CategoryRentalBoat,
+	CategoryRentalCar,
+	CategoryRentalSki,
+	CategoryRentalStorage,
+	CategoryService,
+	CategoryServiceBeauty,
+	CategoryServiceBeautyHairdresser,
+	CategoryServiceBeautyMassage,
+	CategoryServiceBeautySpa,
+	CategoryServiceCleaning,
+	CategoryServiceCleaningDryCleaning,
+	CategoryServiceCleaningLaundry,
+	CategoryServiceCleaningLavoir,
+	CategoryServiceFinancial,
+	CategoryServiceFinancialAtm,
+	CategoryServiceFinancialBank,
+	CategoryServiceFinancialBureauDeChange,
+	CategoryServiceFinancialMoneyLender,
+	CategoryServiceFinancialMoneyTransfer,
+	CategoryServiceFinancialPaymentTerminal,
+	CategoryServiceFireStation,
+	CategoryServiceFuneralDirectors,
+	CategoryServicePolice,
+	CategoryServicePost,
+	CategoryServicePostBox,
+	CategoryServicePostOffice,
+	CategoryServiceSocialFacility,
+	CategoryServiceTaxi,
+	CategoryServiceTravelAgency,
+	CategoryServiceVehicle,
+	CategoryServiceVehicleCarWash,
+	CategoryServiceVehicleChargingStation,
+	CategoryServiceVehicleFuel,
+	CategoryServiceVehicleParts,
+	CategoryServiceVehicleRepair,
+	CategoryServiceVehicleRepairCar,
+	CategoryServiceVehicleRepairMotorcycle,
+	CategorySki,
+	CategorySkiLift,
+	CategorySkiLiftCableCar,
+	CategorySkiLiftChairLift,
+	CategorySkiLiftGondola,
+	CategorySkiLiftMagicCarpet,
+	CategorySkiLiftMixedLift,
+	CategorySkiLiftTowLine,
+	CategorySkiRental,
+	CategorySkiSchool,
+	CategorySport,
+	CategorySportDiveCentre,
+	CategorySportFitness,
+	CategorySportFitnessFitnessCentre,
+	CategorySportFitnessFitnessStation,
+	CategorySportHorseRiding,
+	CategorySportIceRink,
+	CategorySportPitch,
+	CategorySportSportsCentre,
+	CategorySportStadium,
+	CategorySportSwimmingPool,
+	CategorySportTrack,
+	CategoryTourism,
+	CategoryTourismAttraction,
+	CategoryTourismAttractionArtwork,
+	CategoryTourismAttractionClock,
+	CategoryTourismAttractionFountain,
+	CategoryTourismAttractionViewpoint,
+	CategoryTourismInformation,
+	CategoryTourismInformationMap,
+	CategoryTourismInformationOffice,
+	CategoryTourismInformationRangerStation,
+	CategoryTourismSights,
+	CategoryTourismSightsArchaeologicalSite,
+	CategoryTourismSightsBattlefield,
+	CategoryTourismSightsBridge,
+	CategoryTourismSightsCastle,
+	CategoryTourismSightsCityGate,
+	CategoryTourismSightsCityHall,
+	CategoryTourismSightsConferenceCenter,
+	CategoryTourismSightsFort,
+	CategoryTourismSightsLighthouse,
+	CategoryTourismSightsMemorial,
+	CategoryTourismSightsMemorialAircraft,
+	CategoryTourismSightsMemorialBoundaryStone,
+	CategoryTourismSightsMemorialCross,
+	CategoryTourismSightsMemorialMilestone,
+	CategoryTourismSightsMemorialMonument,
+	CategoryTourismSightsMemorialPlaque,
+	CategoryTourismSightsMemorialShip,
+	CategoryTourismSightsMemorialTank,
+	CategoryTourismSightsMemorialTomb,
+	CategoryTourismSightsMemorialWaysideCross,
+	CategoryTourismSightsMonastery,
+	CategoryTourismSightsPlaceOfWorship,
+	CategoryTourismSightsPlaceOfWorshipCathedral,
+	CategoryTourismSightsPlaceOfWorshipChapel,
+	CategoryTourismSightsPlaceOfWorshipChurch,
+	CategoryTourismSightsPlaceOfWorshipMosque,
+	CategoryTourismSightsPlaceOfWorshipShrine,
+	CategoryTourismSightsPlaceOfWorshipSynagogue,
+	CategoryTourismSightsPlaceOfWorshipTemple,
+	CategoryTourismSightsRuines,
+	CategoryTourismSightsTower,
+	CategoryTourismSightsWindmill,
+}
+
+// osmTagCategories maps "key=value" OpenStreetMap tags to categories.
+var osmTagCategories = map[string]Category{
+	"amenity=cafe":                 CategoryCateringCafe,
+	"amenity=restaurant":           CategoryCateringRestaurant,
+	"amenity=fast_food":            CategoryCateringFastFood,
+	"amenity=food_court":           CategoryCateringFoodCourt,
+	"amenity=bar":                  CategoryCateringBar,
+	"amenity=pub":                  CategoryCateringPub,
+	"amenity=ice_cream":            CategoryCateringIceCream,
+	"amenity=biergarten":           CategoryCateringBiergarten,
+	"amenity=school":               CategoryEducationSchool,
+	"amenity=driving_school":       CategoryEducationDrivingSchool,
+	"amenity=music_school":         CategoryEducationMusicSchool,
+	"amenity=language_school":      CategoryEducationLanguageSchool,
+	"amenity=library":              CategoryEducationLibrary,
+	"amenity=college":              CategoryEducationCollege,
+	"amenity=university":           CategoryEducationUniversity,
+	"amenity=kindergarten":         CategoryChildcareKindergarten,
+	"amenity=hospital":             CategoryHealthcareHospital,
+	"amenity=clinic":               CategoryHealthcareClinicOrPraxis,
+	"amenity=doctors":              CategoryHealthcareClinicOrPraxis,
+	"amenity=dentist":              CategoryHealthcareDentist,
+	"amenity=pharmacy":             CategoryHealthcarePharmacy,
+	"amenity=bank":                 CategoryServiceFinancialBank,
+	"amenity=atm":                  CategoryServiceFinancialAtm,
+	"amenity=bureau_de_change":     CategoryServiceFinancialBureauDeChange,
+	"amenity=post_office":          CategoryServicePostOffice,
+	"amenity=post_box":             CategoryServicePostBox,
+	"amenity=police":               CategoryServicePolice,
+	"amenity=fire_station":         CategoryServiceFireStation,
+	"amenity=fuel":                 CategoryServiceVehicleFuel,
+	"amenity=car_wash":             CategoryServiceVehicleCarWash,
+	"amenity=charging_station":     CategoryServiceVehicleChargingStation,
+	"amenity=taxi":                 CategoryServiceTaxi,
+	"amenity=parking":              CategoryParking,
+	"amenity=bicycle_parking":      CategoryParkingBicycles,
+	"amenity=toilets":              CategoryAmenityToilet,
+	"amenity=drinking_water":       CategoryAmenityDrinkingWater,
+	"amenity=cinema":               CategoryEntertainmentCinema,
+	"amenity=theatre":              CategoryEntertainmentCultureTheatre,
+	"amenity=arts_centre":          CategoryEntertainmentCultureArtsCentre,
+	"amenity=nightclub":            CategoryAdultNightclub,
+	"amenity=casino":               CategoryAdultCasino,
+	"amenity=veterinary":           CategoryPetVeterinary,
+	"amenity=place_of_worship":     CategoryReligionPlaceOfWorship,
+	"amenity=marketplace":          CategoryCommercialMarketplace,
+	"amenity=car_rental":           CategoryRentalCar,
+	"amenity=bicycle_rental":       CategoryRentalBicycle,
+	"shop=supermarket":             CategoryCommercialSupermarket,
+	"shop=convenience":             CategoryCommercialConvenience,
+	"shop=mall":                    CategoryCommercialShoppingMall,
+	"shop=department_store":        CategoryCommercialDepartmentStore,
+	"shop=bakery":                  CategoryCommercialFoodAndDrinkBakery,
+	"shop=butcher":                 CategoryCommercialFoodAndDrinkButcher,
+	"shop=books":                   CategoryCommercialBooks,
+	"shop=clothes":                 CategoryCommercialClothing,
+	"shop=shoes":                   CategoryCommercialClothingShoes,
+	"shop=electronics":             CategoryCommercialElektronics,
+	"shop=florist":                 CategoryCommercialFlorist,
+	"shop=furniture":               CategoryCommercialFurnitureAndInterior,
+	"shop=hardware":                CategoryCommercialHousewareAndHardwareHardware,
+	"shop=doityourself":            CategoryCommercialHousewareAndHardwareDoityourself,
+	"shop=chemist":                 CategoryCommercialChemist,
+	"shop=optician":                CategoryCommercialHealthAndBeautyOptician,
+	"shop=cosmetics":               CategoryCommercialHealthAndBeautyCosmetics,
+	"shop=toys":                    CategoryCommercialToyAndGame,
+	"shop=pet":                     CategoryCommercialPet,
+	"shop=jewelry":                 CategoryCommercialJewelry,
+	"shop=kiosk":                   CategoryCommercialKiosk,
+	"shop=bicycle":                 CategoryCommercialOutdoorAndSportBicycle,
+	"shop=car":                     CategoryCommercialVehicle,
+	"shop=hairdresser":             CategoryServiceBeautyHairdresser,
+	"shop=laundry":                 CategoryServiceCleaningLaundry,
+	"shop=dry_cleaning":            CategoryServiceCleaningDryCleaning,
+	"shop=travel_agency":           CategoryServiceTravelAgency,
+	"tourism=hotel":                CategoryAccommodationHotel,
+	"tourism=motel":                CategoryAccommodationMotel,
+	"tourism=hostel":               CategoryAccommodationHostel,
+	"tourism=guest_house":          CategoryAccommodationGuestHouse,
+	"tourism=apartment":            CategoryAccommodationApartment,
+	"tourism=chalet":               CategoryAccommodationChalet,
+	"tourism=camp_site":            CategoryCampingCampSite,
+	"tourism=caravan_site":         CategoryCampingCaravanSite,
+	"tourism=museum":               CategoryEntertainmentMuseum,
+	"tourism=gallery":              CategoryEntertainmentCultureGallery,
+	"tourism=zoo":                  CategoryEntertainmentZoo,
+	"tourism=aquarium":             CategoryEntertainmentAquarium,
+	"tourism=theme_park":           CategoryEntertainmentThemePark,
+	"tourism=information":          CategoryTourismInformation,
+	"tourism=attraction":           CategoryTourismAttraction,
+	"tourism=artwork":              CategoryTourismAttractionArtwork,
+	"tourism=viewpoint":            CategoryTourismAttractionViewpoint,
+	"leisure=park":                 CategoryLeisurePark,
+	"leisure=garden":               CategoryLeisureParkGarden,
+	"leisure=nature_reserve":       CategoryLeisureParkNatureReserve,
+	"leisure=playground":           CategoryLeisurePlayground,
+	"leisure=picnic_table":         CategoryLeisurePicnicPicnicTable,
+	"leisure=sauna":                CategoryLeisureSpaSauna,
+	"leisure=stadium":              CategorySportStadium,
+	"leisure=pitch":                CategorySportPitch,
+	"leisure=sports_centre":        CategorySportSportsCentre,
+	"leisure=swimming_pool":        CategorySportSwimmingPool,
+	"leisure=fitness_centre":       CategorySportFitnessFitnessCentre,
+	"leisure=ice_rink":             CategorySportIceRink,
+	"leisure=dog_park":             CategoryPetDogPark,
+	"leisure=water_park":           CategoryEntertainmentWaterPark,
+	"leisure=bowling_alley":        CategoryEntertainmentBowlingAlley,
+	"leisure=beach_resort":         CategoryBeachBeachResort,
+	"natural=beach":                CategoryBeach,
+	"natural=peak":                 CategoryNaturalMountainPeak,
+	"natural=spring":               CategoryNaturalWaterSpring,
+	"natural=wood":                 CategoryNaturalForest,
+	"landuse=forest":               CategoryNaturalForest,
+	"boundary=national_park":       CategoryNationalPark,
+	"historic=castle":              CategoryTourismSightsCastle,
+	"historic=memorial":            CategoryTourismSightsMemorial,
+	"historic=monument":            CategoryTourismSightsMemorialMonument,
+	"historic=ruins":               CategoryTourismSightsRuines,
+	"historic=archaeological_site": CategoryTourismSightsArchaeologicalSite,
+	"aeroway=aerodrome":            CategoryAirport,
+	"railway=station":              CategoryPublicTransportTrain,
+	"railway=subway_entrance":      CategoryPublicTransportSubwayEntrance,
+	"highway=bus_stop":             CategoryPublicTransportBus,
+	"amenity=ferry_terminal":       CategoryPublicTransportFerry,
+	"office=company":               CategoryOfficeCompany,
+	"office=government":            CategoryOfficeGovernment,
+	"office=insurance":             CategoryOfficeInsurance,
+	"office=lawyer":                CategoryOfficeLawyer,
+	"office=estate_agent":          CategoryOfficeEstateAgent,
+	"craft=brewery":                CategoryProductionBrewery,
+	"craft=winery":                 CategoryProductionWinery,
+	"man_made=lighthouse":          CategoryManMadeLighthouse,
+	"man_made=pier":                CategoryManMadePier,
+}
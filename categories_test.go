@@ -0,0 +1,91 @@
+package geoapify
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestKnownCategories_SortedWithParents(t *testing.T) {
+	if !slices.IsSorted(knownCategories) {
+		t.Fatal("knownCategories is not sorted")
+	}
+	for _, c := range knownCategories {
+		if p := c.Parent(); p != "" && !p.Valid() {
+			t.Errorf("category %q has unknown parent %q", c, p)
+		}
+	}
+}
+
+func TestCategory_Tree(t *testing.T) {
+	assertEqual(t, CategoryCateringCafeCoffee.Parent(), CategoryCateringCafe)
+	assertEqual(t, CategoryCatering.Parent(), Category(""))
+
+	assertEqual(t, CategoryCatering.Includes(CategoryCateringCafeCoffee), true)
+	assertEqual(t, CategoryCatering.Includes(CategoryCatering), true)
+	assertEqual(t, CategoryCateringCafe.Includes(CategoryCatering), false)
+	assertEqual(t, Category("cat").Includes(CategoryCatering), false)
+
+	children := CategoryLeisurePark.Children()
+	assertEqual(t, slices.Equal(children, []Category{CategoryLeisureParkGarden, CategoryLeisureParkNatureReserve}), true)
+	assertEqual(t, len(CategoryLeisureParkGarden.Children()), 0)
+
+	top := TopLevelCategories()
+	assertEqual(t, slices.Contains(top, CategoryAccommodation), true)
+	assertEqual(t, slices.Contains(top, CategoryCateringCafe), false)
+}
+
+func TestParseCategory(t *testing.T) {
+	c, err := ParseCategory(" catering.cafe ")
+	assertNoError(t, err)
+	assertEqual(t, c, CategoryCateringCafe)
+
+	_, err = ParseCategory("catering.caffe")
+	assertError(t, err)
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `did you mean "catering.cafe"`) {
+		t.Errorf("expected a suggestion, got %v", err)
+	}
+
+	_, err = ParseCategory("zzz")
+	assertError(t, err)
+	if strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("unexpected suggestion: %v", err)
+	}
+
+	cs, err := ParseCategories("catering.cafe,leisure.park")
+	assertNoError(t, err)
+	assertEqual(t, slices.Equal(cs, []Category{CategoryCateringCafe, CategoryLeisurePark}), true)
+
+	_, err = ParseCategories("catering.cafe,leisure.prak")
+	assertError(t, err)
+}
+
+func TestParseCondition(t *testing.T) {
+	c, err := ParseCondition("wheelchair")
+	assertNoError(t, err)
+	assertEqual(t, c, ConditionWheelchair)
+
+	_, err = ParseCondition("wheelchairs")
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument, got %v", err)
+	}
+}
+
+func TestCategoryForOSMTag(t *testing.T) {
+	c, ok := CategoryForOSMTag("amenity", "cafe")
+	assertEqual(t, ok, true)
+	assertEqual(t, c, CategoryCateringCafe)
+
+	for tag, c := range osmTagCategories {
+		if !c.Valid() {
+			t.Errorf("tag %s maps to unknown category %q", tag, c)
+		}
+	}
+
+	_, ok = CategoryForOSMTag("amenity", "unknown")
+	assertEqual(t, ok, false)
+}
@@ -20,14 +20,19 @@ import (
 	"strings"
 )
 
-const defaultBaseURL = "https://api.geoapify.com"
+const (
+	defaultBaseURL     = "https://api.geoapify.com"
+	defaultMapsBaseURL = "https://maps.geoapify.com"
+)
 
 // Client is the GeoApify API client.
 type Client struct {
-	apiKey     string
-	baseURL    string
-	httpClient *http.Client
-	retry      *retryConfig
+	apiKey      string
+	baseURL     string
+	mapsBaseURL string
+	httpClient  *http.Client
+	retry       *retryConfig
+	limiter     *rateLimiter
 }
 
 // Option configures the Client.
@@ -47,12 +52,21 @@ func WithBaseURL(url string) Option {
 	}
 }
 
+// WithMapsBaseURL overrides the base URL of the map rendering APIs (static
+// maps and tiles).
+func WithMapsBaseURL(url string) Option {
+	return func(client *Client) {
+		client.mapsBaseURL = strings.TrimRight(url, "/")
+	}
+}
+
 // NewClient creates a new GeoApify client with the given API key and options.
 func NewClient(apiKey string, opts ...Option) *Client {
 	c := &Client{
-		apiKey:     apiKey,
-		baseURL:    defaultBaseURL,
-		httpClient: http.DefaultClient,
+		apiKey:      apiKey,
+		baseURL:     defaultBaseURL,
+		mapsBaseURL: defaultMapsBaseURL,
+		httpClient:  http.DefaultClient,
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -61,11 +75,15 @@ func NewClient(apiKey string, opts ...Option) *Client {
 }
 
 func (c *Client) buildURL(path string, params url.Values) string {
+	return c.buildURLWithBase(c.baseURL, path, params)
+}
+
+func (c *Client) buildURLWithBase(base, path string, params url.Values) string {
 	if params == nil {
 		params = url.Values{}
 	}
 	params.Set("apiKey", c.apiKey)
-	return fmt.Sprintf("%s%s?%s", c.baseURL, path, params.Encode())
+	return fmt.Sprintf("%s%s?%s", base, path, params.Encode())
 }
 
 func (c *Client) doGet(ctx context.Context, path string, params url.Values, result any) error {
@@ -94,8 +112,25 @@ func (c *Client) doPost(ctx context.Context, path string, params url.Values, bod
 	return c.do(req, result)
 }
 
+// doGetBytes fetches a binary resource, such as an image, from an absolute
+// URL built with buildURLWithBase.
+func (c *Client) doGetBytes(ctx context.Context, reqURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	var body []byte
+	if err := c.do(req, &body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
 func (c *Client) do(req *http.Request, result any) error {
 	execute := func() error {
+		if err := c.limiter.wait(req.Context()); err != nil {
+			return err
+		}
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
 			return fmt.Errorf("executing request: %w", err)
@@ -111,17 +146,14 @@ func (c *Client) do(req *http.Request, result any) error {
 			return newAPIError(resp.StatusCode, respBody)
 		}
 
-		if result != nil {
-			if err := json.Unmarshal(respBody, result); err != nil {
-				return fmt.Errorf("decoding response: %w", err)
-			}
-		}
-
-		return nil
+		return decodeResult(respBody, result)
 	}
 
 	if c.retry != nil {
 		return c.retry.do(req.Context(), func() (*retryHint, error) {
+			if err := c.limiter.wait(req.Context()); err != nil {
+				return nil, err
+			}
 			resp, err := c.httpClient.Do(req)
 			if err != nil {
 				return nil, fmt.Errorf("executing request: %w", err)
@@ -145,18 +177,29 @@ func (c *Client) do(req *http.Request, result any) error {
 				return nil, apiErr
 			}
 
-			if result != nil {
-				if err := json.Unmarshal(respBody, result); err != nil {
-					return nil, fmt.Errorf("decoding response: %w", err)
-				}
-			}
-			return nil, nil
+			return nil, decodeResult(respBody, result)
 		})
 	}
 
 	return execute()
 }
 
+// decodeResult stores the response body into result. A *[]byte receives the
+// raw body; anything else is decoded as JSON.
+func decodeResult(body []byte, result any) error {
+	switch r := result.(type) {
+	case nil:
+		return nil
+	case *[]byte:
+		*r = body
+		return nil
+	}
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
+
 func isRetryable(statusCode int) bool {
 	return statusCode == http.StatusTooManyRequests || statusCode >= 500
 }
@@ -220,3 +263,28 @@ func (c *Client) BatchGeocoding() *BatchGeocodingService {
 func (c *Client) Postcode() *PostcodeService {
 	return &PostcodeService{client: c}
 }
+
+// Geometry returns a geometry operations service.
+func (c *Client) Geometry() *GeometryService {
+	return &GeometryService{client: c}
+}
+
+// StaticMaps returns a static maps service.
+func (c *Client) StaticMaps() *StaticMapsService {
+	return &StaticMapsService{client: c}
+}
+
+// Tiles returns a map tiles service.
+func (c *Client) Tiles() *TilesService {
+	return &TilesService{client: c}
+}
+
+// Icons returns a marker icon service.
+func (c *Client) Icons() *IconsService {
+	return &IconsService{client: c}
+}
+
+// Batch returns a generic batch service.
+func (c *Client) Batch() *BatchService {
+	return &BatchService{client: c}
+}
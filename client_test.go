@@ -132,4 +132,19 @@ func TestClient_ServiceAccessors(t *testing.T) {
 	if client.Postcode() == nil {
 		t.Error("Postcode() returned nil")
 	}
+	if client.Geometry() == nil {
+		t.Error("Geometry() returned nil")
+	}
+	if client.StaticMaps() == nil {
+		t.Error("StaticMaps() returned nil")
+	}
+	if client.Tiles() == nil {
+		t.Error("Tiles() returned nil")
+	}
+	if client.Icons() == nil {
+		t.Error("Icons() returned nil")
+	}
+	if client.Batch() == nil {
+		t.Error("Batch() returned nil")
+	}
 }
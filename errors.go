@@ -6,6 +6,10 @@ import (
 	"fmt"
 )
 
+// ErrInvalidArgument is returned when a request is built with invalid input.
+// It is detected before any API call is made.
+var ErrInvalidArgument = errors.New("geoapify: invalid argument")
+
 // APIError represents an error returned by the GeoApify API.
 type APIError struct {
 	StatusCode int    `json:"statusCode"`
@@ -0,0 +1,226 @@
+package geoapify
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+)
+
+// Filter restricts geocoding, places and postcode results to an area.
+//
+// Filters are created with the constructors below, which validate their
+// input. An invalid filter carries its error, which is returned by the Do
+// method of the request it is passed to.
+type Filter struct {
+	value string
+	err   error
+}
+
+// String returns the filter in the API's "kind:value" format.
+func (f Filter) String() string {
+	return f.value
+}
+
+// Err returns the validation error of the filter, if any.
+func (f Filter) Err() error {
+	return f.err
+}
+
+// And combines the filter with others, so that results must match all of
+// them.
+func (f Filter) And(others ...Filter) Filter {
+	out := f
+	for _, o := range others {
+		if out.err == nil {
+			out.err = o.err
+		}
+		if o.value == "" {
+			continue
+		}
+		if out.value != "" {
+			out.value += "|"
+		}
+		out.value += o.value
+	}
+	return out
+}
+
+// Bias ranks geocoding, places and postcode results closer to an area higher
+// without excluding others.
+//
+// Like Filter, an invalid bias carries its error until the request is run.
+type Bias struct {
+	value string
+	err   error
+}
+
+// String returns the bias in the API's "kind:value" format.
+func (b Bias) String() string {
+	return b.value
+}
+
+// Err returns the validation error of the bias, if any.
+func (b Bias) Err() error {
+	return b.err
+}
+
+// CountryFilter creates a country code filter from ISO 3166-1 alpha-2 codes.
+// The special code "none" matches results outside of any country.
+func CountryFilter(codes ...string) Filter {
+	value, err := countryCodes(codes, "none")
+	return Filter{value: "countrycode:" + value, err: err}
+}
+
+// CircleFilter creates a circle filter.
+func CircleFilter(lon, lat, radiusMeters float64) Filter {
+	value, err := circleValue(lon, lat, radiusMeters)
+	return Filter{value: "circle:" + value, err: err}
+}
+
+// RectFilter creates a rectangle filter from two opposite corners.
+func RectFilter(lon1, lat1, lon2, lat2 float64) Filter {
+	value, err := rectValue(lon1, lat1, lon2, lat2)
+	return Filter{value: "rect:" + value, err: err}
+}
+
+// PlaceFilter creates a place ID filter, limiting results to the boundary of
+// the given place.
+func PlaceFilter(placeID string) Filter {
+	f := Filter{value: "place:" + placeID}
+	if strings.TrimSpace(placeID) == "" {
+		f.err = fmt.Errorf("%w: empty place ID", ErrInvalidArgument)
+	}
+	return f
+}
+
+// GeometryFilter creates a geometry filter, limiting results to a geometry
+// stored by the API, such as an isoline ID.
+func GeometryFilter(geometryID string) Filter {
+	f := Filter{value: "geometry:" + geometryID}
+	if strings.TrimSpace(geometryID) == "" {
+		f.err = fmt.Errorf("%w: empty geometry ID", ErrInvalidArgument)
+	}
+	return f
+}
+
+// RawFilter creates a filter from a preformatted "kind:value" string, for
+// filter kinds this package does not model.
+func RawFilter(v string) Filter {
+	f := Filter{value: v}
+	if kind, _, ok := strings.Cut(v, ":"); !ok || kind == "" {
+		f.err = fmt.Errorf("%w: filter %q is not in kind:value format", ErrInvalidArgument, v)
+	}
+	return f
+}
+
+// ProximityBias creates a proximity bias.
+func ProximityBias(lon, lat float64) Bias {
+	return Bias{value: "proximity:" + formatCoord(lon) + "," + formatCoord(lat), err: validateLonLat(lon, lat)}
+}
+
+// CircleBias creates a circle bias.
+func CircleBias(lon, lat, radiusMeters float64) Bias {
+	value, err := circleValue(lon, lat, radiusMeters)
+	return Bias{value: "circle:" + value, err: err}
+}
+
+// RectBias creates a rectangle bias from two opposite corners.
+func RectBias(lon1, lat1, lon2, lat2 float64) Bias {
+	value, err := rectValue(lon1, lat1, lon2, lat2)
+	return Bias{value: "rect:" + value, err: err}
+}
+
+// CountryBias creates a country code bias from ISO 3166-1 alpha-2 codes.
+// The special code "auto" biases towards the caller's country.
+func CountryBias(codes ...string) Bias {
+	value, err := countryCodes(codes, "auto")
+	return Bias{value: "countrycode:" + value, err: err}
+}
+
+// RawBias creates a bias from a preformatted "kind:value" string.
+func RawBias(v string) Bias {
+	b := Bias{value: v}
+	if kind, _, ok := strings.Cut(v, ":"); !ok || kind == "" {
+		b.err = fmt.Errorf("%w: bias %q is not in kind:value format", ErrInvalidArgument, v)
+	}
+	return b
+}
+
+// setFilterParams validates filters and biases and sets the filter and bias
+// query parameters.
+func setFilterParams(params url.Values, filters []Filter, biases []Bias) error {
+	if len(filters) > 0 {
+		f := Filter{}.And(filters...)
+		if f.err != nil {
+			return f.err
+		}
+		params.Set("filter", f.value)
+	}
+	if len(biases) > 0 {
+		parts := make([]string, len(biases))
+		for i, b := range biases {
+			if b.err != nil {
+				return b.err
+			}
+			parts[i] = b.value
+		}
+		params.Set("bias", strings.Join(parts, "|"))
+	}
+	return nil
+}
+
+func validateLonLat(lon, lat float64) error {
+	if math.IsNaN(lon) || lon < -180 || lon > 180 {
+		return fmt.Errorf("%w: longitude %g out of range", ErrInvalidArgument, lon)
+	}
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return fmt.Errorf("%w: latitude %g out of range (are lon and lat swapped?)", ErrInvalidArgument, lat)
+	}
+	return nil
+}
+
+func circleValue(lon, lat, radiusMeters float64) (string, error) {
+	value := formatCoord(lon) + "," + formatCoord(lat) + "," + formatCoord(radiusMeters)
+	if err := validateLonLat(lon, lat); err != nil {
+		return value, err
+	}
+	if !(radiusMeters > 0) || math.IsInf(radiusMeters, 0) {
+		return value, fmt.Errorf("%w: radius %g must be positive", ErrInvalidArgument, radiusMeters)
+	}
+	return value, nil
+}
+
+func rectValue(lon1, lat1, lon2, lat2 float64) (string, error) {
+	value := formatCoord(lon1) + "," + formatCoord(lat1) + "," + formatCoord(lon2) + "," + formatCoord(lat2)
+	if err := validateLonLat(lon1, lat1); err != nil {
+		return value, err
+	}
+	if err := validateLonLat(lon2, lat2); err != nil {
+		return value, err
+	}
+	if lon1 == lon2 || lat1 == lat2 {
+		return value, fmt.Errorf("%w: rectangle has zero area", ErrInvalidArgument)
+	}
+	return value, nil
+}
+
+// countryCodes lowercases and validates ISO 3166-1 alpha-2 codes. special is
+// an additional keyword accepted in place of a code.
+func countryCodes(codes []string, special string) (string, error) {
+	if len(codes) == 0 {
+		return "", fmt.Errorf("%w: no country codes given", ErrInvalidArgument)
+	}
+	out := make([]string, len(codes))
+	for i, c := range codes {
+		c = strings.ToLower(strings.TrimSpace(c))
+		out[i] = c
+		if c == special {
+			continue
+		}
+		if len(c) != 2 || c[0] < 'a' || c[0] > 'z' || c[1] < 'a' || c[1] > 'z' {
+			return strings.Join(out, ","), fmt.Errorf("%w: invalid country code %q", ErrInvalidArgument, codes[i])
+		}
+	}
+	return strings.Join(out, ","), nil
+}
@@ -0,0 +1,102 @@
+package geoapify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestFilters_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter Filter
+		want   string
+	}{
+		{"country", CountryFilter("US", "ca"), "countrycode:us,ca"},
+		{"country none", CountryFilter("none"), "countrycode:none"},
+		{"circle", CircleFilter(-87.77, 41.87, 5000), "circle:-87.77,41.87,5000"},
+		{"rect", RectFilter(-130, 20, -60, 50), "rect:-130,20,-60,50"},
+		{"place", PlaceFilter("abc"), "place:abc"},
+		{"geometry", GeometryFilter("iso-1"), "geometry:iso-1"},
+		{"raw", RawFilter("foo:bar"), "foo:bar"},
+		{"place and rect", PlaceFilter("abc").And(RectFilter(1, 2, 3, 4)), "place:abc|rect:1,2,3,4"},
+		{"repeated kind", CountryFilter("us").And(CountryFilter("ca")), "countrycode:us|countrycode:ca"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertNoError(t, tt.filter.Err())
+			assertEqual(t, tt.filter.String(), tt.want)
+		})
+	}
+}
+
+func TestFilters_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"bad country code", CountryFilter("usa").Err()},
+		{"no country codes", CountryFilter().Err()},
+		{"auto is bias only", CountryFilter("auto").Err()},
+		{"swapped lon lat", CircleFilter(41.87, -187.77, 100).Err()},
+		{"negative radius", CircleFilter(0, 0, -1).Err()},
+		{"degenerate rect", RectFilter(1, 2, 1, 4).Err()},
+		{"empty place", PlaceFilter("").Err()},
+		{"empty geometry", GeometryFilter(" ").Err()},
+		{"raw without kind", RawFilter("nokind").Err()},
+		{"invalid part", PlaceFilter("abc").And(CircleFilter(0, 0, 0)).Err()},
+		{"bad proximity", ProximityBias(200, 0).Err()},
+		{"bad country bias", CountryBias("1x").Err()},
+		{"raw bias without kind", RawBias("").Err()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, ErrInvalidArgument) {
+				t.Fatalf("expected ErrInvalidArgument, got %v", tt.err)
+			}
+		})
+	}
+}
+
+func TestBiases_Format(t *testing.T) {
+	assertEqual(t, ProximityBias(13, 52).String(), "proximity:13,52")
+	assertEqual(t, CircleBias(13, 52, 100).String(), "circle:13,52,100")
+	assertEqual(t, RectBias(1, 2, 3, 4).String(), "rect:1,2,3,4")
+	assertEqual(t, CountryBias("auto").String(), "countrycode:auto")
+	assertEqual(t, RawBias("proximity:IP").String(), "proximity:IP")
+}
+
+func TestFilters_InvalidFilterFailsBeforeRequest(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	})
+
+	_, err := client.Geocoding().Search("test").
+		WithFilter(CountryFilter("xyz")).
+		Do(context.Background())
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument, got %v", err)
+	}
+
+	_, err = client.Places().Categories("catering").
+		WithBias(ProximityBias(0, 95)).
+		Do(context.Background())
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument, got %v", err)
+	}
+}
+
+func TestPlaces_GeometryFilter(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assertEqual(t, r.URL.Query().Get("filter"), "geometry:iso-1")
+		w.Write([]byte(`{"type":"FeatureCollection","features":[]}`))
+	})
+
+	_, err := client.Places().Categories("catering.cafe").
+		WithFilter(GeometryFilter("iso-1")).
+		Do(context.Background())
+	assertNoError(t, err)
+}
@@ -49,8 +49,8 @@ type SearchRequest struct {
 	locType     LocationType
 	lang        string
 	limit       int
-	filters     []string
-	biases      []string
+	filters     []Filter
+	biases      []Bias
 	format      Format
 }
 
@@ -104,6 +104,19 @@ func (r *SearchRequest) WithHouseNumber(v string) *SearchRequest {
 	return r
 }
 
+// WithAddress sets every structured address field at once. Empty fields
+// clear the corresponding value.
+func (r *SearchRequest) WithAddress(a StructuredAddress) *SearchRequest {
+	r.name = a.Name
+	r.houseNumber = a.HouseNumber
+	r.street = a.Street
+	r.postcode = a.Postcode
+	r.city = a.City
+	r.state = a.State
+	r.country = a.Country
+	return r
+}
+
 // WithType sets the location type filter.
 func (r *SearchRequest) WithType(t LocationType) *SearchRequest {
 	r.locType = t
@@ -123,13 +136,13 @@ func (r *SearchRequest) WithLimit(n int) *SearchRequest {
 }
 
 // WithFilter adds geocoding filters (joined with |).
-func (r *SearchRequest) WithFilter(filters ...string) *SearchRequest {
+func (r *SearchRequest) WithFilter(filters ...Filter) *SearchRequest {
 	r.filters = append(r.filters, filters...)
 	return r
 }
 
 // WithBias adds geocoding biases (joined with |).
-func (r *SearchRequest) WithBias(biases ...string) *SearchRequest {
+func (r *SearchRequest) WithBias(biases ...Bias) *SearchRequest {
 	r.biases = append(r.biases, biases...)
 	return r
 }
@@ -140,8 +153,7 @@ func (r *SearchRequest) WithFormat(f Format) *SearchRequest {
 	return r
 }
 
-// Do executes the forward geocoding request.
-func (r *SearchRequest) Do(ctx context.Context) (*GeocodingResponse, error) {
+func (r *SearchRequest) params() (url.Values, error) {
 	params := url.Values{}
 	params.Set("text", r.text)
 
@@ -175,15 +187,21 @@ func (r *SearchRequest) Do(ctx context.Context) (*GeocodingResponse, error) {
 	if r.limit > 0 {
 		params.Set("limit", fmt.Sprintf("%d", r.limit))
 	}
-	if len(r.filters) > 0 {
-		params.Set("filter", strings.Join(r.filters, "|"))
-	}
-	if len(r.biases) > 0 {
-		params.Set("bias", strings.Join(r.biases, "|"))
+	if err := setFilterParams(params, r.filters, r.biases); err != nil {
+		return nil, err
 	}
 	if r.format != "" {
 		params.Set("format", string(r.format))
 	}
+	return params, nil
+}
+
+// Do executes the forward geocoding request.
+func (r *SearchRequest) Do(ctx context.Context) (*GeocodingResponse, error) {
+	params, err := r.params()
+	if err != nil {
+		return nil, err
+	}
 
 	var resp GeocodingResponse
 	if err := r.client.doGet(ctx, "/v1/geocode/search", params, &resp); err != nil {
@@ -191,3 +209,29 @@ func (r *SearchRequest) Do(ctx context.Context) (*GeocodingResponse, error) {
 	}
 	return &resp, nil
 }
+
+// StructuredAddress holds the address fields of a structured geocoding
+// query. It is accepted by SearchRequest.WithAddress and by structured
+// batch jobs.
+type StructuredAddress struct {
+	Name        string `json:"name,omitempty"`
+	HouseNumber string `json:"housenumber,omitempty"`
+	Street      string `json:"street,omitempty"`
+	Postcode    string `json:"postcode,omitempty"`
+	City        string `json:"city,omitempty"`
+	State       string `json:"state,omitempty"`
+	Country     string `json:"country,omitempty"`
+}
+
+// String joins the fields into a free-text address.
+func (a StructuredAddress) String() string {
+	street := strings.TrimSpace(a.HouseNumber + " " + a.Street)
+	city := strings.TrimSpace(a.Postcode + " " + a.City)
+	var parts []string
+	for _, p := range []string{a.Name, street, city, a.State, a.Country} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -63,8 +63,8 @@ func TestSearch_AllBuilderOptions(t *testing.T) {
 func TestSearch_FilterAndBias(t *testing.T) {
 	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
 		q := r.URL.Query()
-		assertEqual(t, q.Get("filter"), "countrycode:us,ca|circle:0.000000,0.000000,5000.000000")
-		assertEqual(t, q.Get("bias"), "proximity:-122.000000,47.000000|countrycode:us")
+		assertEqual(t, q.Get("filter"), "countrycode:us,ca|circle:0,0,5000")
+		assertEqual(t, q.Get("bias"), "proximity:-122,47|countrycode:us")
 		w.Write(mustJSON(t, GeocodingResponse{Results: []Address{}}))
 	})
 
@@ -136,3 +136,21 @@ func TestSearch_APIError(t *testing.T) {
 	assertEqual(t, apiErr.StatusCode, 401)
 	assertEqual(t, apiErr.Message, "Invalid API key")
 }
+
+func TestSearch_WithAddress(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assertEqual(t, q.Get("housenumber"), "1")
+		assertEqual(t, q.Get("street"), "Main St")
+		assertEqual(t, q.Get("postcode"), "98402")
+		assertEqual(t, q.Get("city"), "Tacoma")
+		assertEqual(t, q.Get("country"), "US")
+		assertEqual(t, q.Get("name"), "")
+		w.Write(mustJSON(t, GeocodingResponse{Results: []Address{{City: "Tacoma"}}}))
+	})
+
+	addr := StructuredAddress{HouseNumber: "1", Street: "Main St", Postcode: "98402", City: "Tacoma", Country: "US"}
+	_, err := client.Geocoding().Search("").WithName("ignored").WithAddress(addr).Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, addr.String(), "1 Main St, 98402 Tacoma, US")
+}
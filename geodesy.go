@@ -0,0 +1,248 @@
+package geoapify
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// earthRadius is the mean Earth radius in meters, used by the spherical
+// formulas below.
+const earthRadius = 6371008.8
+
+// WGS-84 ellipsoid parameters used by VincentyDistance.
+const (
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+	wgs84B = wgs84A * (1 - wgs84F)
+)
+
+// ErrVincentyNoConvergence is returned by VincentyDistance for nearly
+// antipodal points. HaversineDistance can be used as a fallback.
+var ErrVincentyNoConvergence = errors.New("geoapify: vincenty formula failed to converge")
+
+func toRadians(deg float64) float64 { return deg * math.Pi / 180 }
+func toDegrees(rad float64) float64 { return rad * 180 / math.Pi }
+
+// normalizeLon wraps a longitude into [-180, 180).
+func normalizeLon(lon float64) float64 {
+	lon = math.Mod(lon+540, 360) - 180
+	if lon == 180 {
+		return -180
+	}
+	return lon
+}
+
+// HaversineDistance returns the great-circle distance to another location in
+// meters, assuming a spherical Earth.
+func (l Location) HaversineDistance(to Location) float64 {
+	lat1, lat2 := toRadians(l.Lat), toRadians(to.Lat)
+	dLat := lat2 - lat1
+	dLon := toRadians(to.Lon - l.Lon)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	// Rounding can push a past 1 for antipodal points.
+	a = math.Min(a, 1)
+	return 2 * earthRadius * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// VincentyDistance returns the distance to another location in meters on the
+// WGS-84 ellipsoid. It is accurate to within millimeters but may fail to
+// converge for nearly antipodal points.
+func (l Location) VincentyDistance(to Location) (float64, error) {
+	L := toRadians(to.Lon - l.Lon)
+	U1 := math.Atan((1 - wgs84F) * math.Tan(toRadians(l.Lat)))
+	U2 := math.Atan((1 - wgs84F) * math.Tan(toRadians(to.Lat)))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+
+	lambda := L
+	var sinSigma, cosSigma, sigma, cosSqAlpha, cos2SigmaM float64
+	for i := 0; ; i++ {
+		if i == 200 {
+			return 0, ErrVincentyNoConvergence
+		}
+		sinLambda, cosLambda := math.Sincos(lambda)
+		sinSigma = math.Sqrt((cosU2*sinLambda)*(cosU2*sinLambda) +
+			(cosU1*sinU2-sinU1*cosU2*cosLambda)*(cosU1*sinU2-sinU1*cosU2*cosLambda))
+		if sinSigma == 0 {
+			return 0, nil // coincident points
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0
+		if cosSqAlpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+		C := wgs84F / 16 * cosSqAlpha * (4 + wgs84F*(4-3*cosSqAlpha))
+		prev := lambda
+		lambda = L + (1-C)*wgs84F*sinAlpha*
+			(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-prev) < 1e-12 {
+			break
+		}
+	}
+
+	uSq := cosSqAlpha * (wgs84A*wgs84A - wgs84B*wgs84B) / (wgs84B * wgs84B)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+	return wgs84B * A * (sigma - deltaSigma), nil
+}
+
+// InitialBearing returns the initial great-circle bearing towards another
+// location, in degrees clockwise from north in [0, 360).
+func (l Location) InitialBearing(to Location) float64 {
+	lat1, lat2 := toRadians(l.Lat), toRadians(to.Lat)
+	dLon := toRadians(to.Lon - l.Lon)
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	return math.Mod(toDegrees(math.Atan2(y, x))+360, 360)
+}
+
+// FinalBearing returns the bearing on arrival at another location when
+// following the great circle, in degrees clockwise from north in [0, 360).
+func (l Location) FinalBearing(to Location) float64 {
+	return math.Mod(to.InitialBearing(l)+180, 360)
+}
+
+// Destination returns the location reached by travelling the given distance
+// in meters along a great circle starting at the given bearing in degrees.
+func (l Location) Destination(bearing, distanceMeters float64) Location {
+	lat1, lon1 := toRadians(l.Lat), toRadians(l.Lon)
+	brng := toRadians(bearing)
+	d := distanceMeters / earthRadius
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(d) + math.Cos(lat1)*math.Sin(d)*math.Cos(brng))
+	lon2 := lon1 + math.Atan2(math.Sin(brng)*math.Sin(d)*math.Cos(lat1),
+		math.Cos(d)-math.Sin(lat1)*math.Sin(lat2))
+	return LatLon(toDegrees(lat2), normalizeLon(toDegrees(lon2)))
+}
+
+// Midpoint returns the point halfway along the great circle to another
+// location.
+func (l Location) Midpoint(to Location) Location {
+	return l.Interpolate(to, 0.5)
+}
+
+// Interpolate returns the point at the given fraction of the great circle
+// path to another location. A fraction of 0 returns l and 1 returns to.
+// Antipodal points are joined by every great circle; the one heading north
+// from l is used.
+func (l Location) Interpolate(to Location, fraction float64) Location {
+	lat1, lon1 := toRadians(l.Lat), toRadians(l.Lon)
+	lat2, lon2 := toRadians(to.Lat), toRadians(to.Lon)
+	d := l.HaversineDistance(to) / earthRadius
+	if d == 0 {
+		return l
+	}
+	if math.Pi-d < 1e-9 {
+		return l.Destination(0, fraction*d*earthRadius)
+	}
+
+	a := math.Sin((1-fraction)*d) / math.Sin(d)
+	b := math.Sin(fraction*d) / math.Sin(d)
+	x := a*math.Cos(lat1)*math.Cos(lon1) + b*math.Cos(lat2)*math.Cos(lon2)
+	y := a*math.Cos(lat1)*math.Sin(lon1) + b*math.Cos(lat2)*math.Sin(lon2)
+	z := a*math.Sin(lat1) + b*math.Sin(lat2)
+	return LatLon(toDegrees(math.Atan2(z, math.Sqrt(x*x+y*y))), toDegrees(math.Atan2(y, x)))
+}
+
+// BBox is a bounding box. When MinLon is greater than MaxLon the box crosses
+// the antimeridian.
+type BBox struct {
+	MinLon float64
+	MinLat float64
+	MaxLon float64
+	MaxLat float64
+}
+
+// BoundingBox returns the smallest box containing every point within the
+// given radius in meters of the location.
+func (l Location) BoundingBox(radiusMeters float64) BBox {
+	d := radiusMeters / earthRadius
+	lat := toRadians(l.Lat)
+	minLat := toDegrees(lat - d)
+	maxLat := toDegrees(lat + d)
+
+	// The circle covers a pole, so the box spans all longitudes.
+	if maxLat >= 90 || minLat <= -90 {
+		return BBox{
+			MinLon: -180,
+			MinLat: math.Max(minLat, -90),
+			MaxLon: 180,
+			MaxLat: math.Min(maxLat, 90),
+		}
+	}
+
+	dLon := toDegrees(math.Asin(math.Sin(d) / math.Cos(lat)))
+	return BBox{
+		MinLon: normalizeLon(l.Lon - dLon),
+		MinLat: minLat,
+		MaxLon: normalizeLon(l.Lon + dLon),
+		MaxLat: maxLat,
+	}
+}
+
+// Contains reports whether the location lies inside the box.
+func (b BBox) Contains(loc Location) bool {
+	if loc.Lat < b.MinLat || loc.Lat > b.MaxLat {
+		return false
+	}
+	if b.MinLon <= b.MaxLon {
+		return loc.Lon >= b.MinLon && loc.Lon <= b.MaxLon
+	}
+	return loc.Lon >= b.MinLon || loc.Lon <= b.MaxLon
+}
+
+// Center returns the center of the box.
+func (b BBox) Center() Location {
+	maxLon := b.MaxLon
+	if b.MinLon > maxLon {
+		maxLon += 360
+	}
+	return LatLon((b.MinLat+b.MaxLat)/2, normalizeLon((b.MinLon+maxLon)/2))
+}
+
+// Filter returns a rectangle filter covering the box. A rectangle cannot
+// cross the antimeridian, so the filter of such a box carries an error
+// wrapping ErrInvalidArgument.
+func (b BBox) Filter() Filter {
+	f := RectFilter(b.MinLon, b.MinLat, b.MaxLon, b.MaxLat)
+	if f.err == nil {
+		f.err = b.checkRect()
+	}
+	return f
+}
+
+// Bias returns a rectangle bias covering the box. As with Filter, a box
+// crossing the antimeridian yields a bias carrying an error.
+func (b BBox) Bias() Bias {
+	bias := RectBias(b.MinLon, b.MinLat, b.MaxLon, b.MaxLat)
+	if bias.err == nil {
+		bias.err = b.checkRect()
+	}
+	return bias
+}
+
+func (b BBox) checkRect() error {
+	if b.MinLon > b.MaxLon {
+		return fmt.Errorf("%w: box %f,%f,%f,%f crosses the antimeridian and cannot be used as a rectangle", ErrInvalidArgument, b.MinLon, b.MinLat, b.MaxLon, b.MaxLat)
+	}
+	return nil
+}
+
+// CircleFilter returns a circle filter of the given radius around the
+// location.
+func (l Location) CircleFilter(radiusMeters float64) Filter {
+	return CircleFilter(l.Lon, l.Lat, radiusMeters)
+}
+
+// ProximityBias returns a proximity bias towards the location.
+func (l Location) ProximityBias() Bias {
+	return ProximityBias(l.Lon, l.Lat)
+}
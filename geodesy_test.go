@@ -0,0 +1,133 @@
+package geoapify
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// assertNear fails the test if got is not within tol of want.
+func assertNear(t *testing.T, got, want, tol float64) {
+	t.Helper()
+	if math.Abs(got-want) > tol {
+		t.Errorf("got %v, want %v ± %v", got, want, tol)
+	}
+}
+
+var (
+	paris  = LatLon(48.8566, 2.3522)
+	london = LatLon(51.5074, -0.1278)
+)
+
+func TestLocation_HaversineDistance(t *testing.T) {
+	assertNear(t, paris.HaversineDistance(london), 343_560, 500)
+	assertEqual(t, paris.HaversineDistance(paris), 0.0)
+}
+
+func TestLocation_VincentyDistance(t *testing.T) {
+	d, err := paris.VincentyDistance(london)
+	assertNoError(t, err)
+	assertNear(t, d, 343_923, 50)
+
+	// Flinders Peak to Buninyong, the classic Vincenty reference pair.
+	d, err = LatLon(-37.95103342, 144.42486789).VincentyDistance(LatLon(-37.65282114, 143.92649554))
+	assertNoError(t, err)
+	assertNear(t, d, 54972.271, 0.01)
+
+	d, err = paris.VincentyDistance(paris)
+	assertNoError(t, err)
+	assertEqual(t, d, 0.0)
+
+	_, err = LatLon(0, 0).VincentyDistance(LatLon(0.5, 179.7))
+	if !errors.Is(err, ErrVincentyNoConvergence) {
+		t.Fatalf("expected ErrVincentyNoConvergence, got %v", err)
+	}
+}
+
+func TestLocation_Bearings(t *testing.T) {
+	assertNear(t, LatLon(0, 0).InitialBearing(LatLon(1, 0)), 0, 1e-9)
+	assertNear(t, LatLon(0, 0).InitialBearing(LatLon(0, 1)), 90, 1e-9)
+	assertNear(t, LatLon(0, 0).InitialBearing(LatLon(0, -1)), 270, 1e-9)
+	assertNear(t, paris.InitialBearing(london), 330.0, 1)
+	assertNear(t, paris.FinalBearing(london), 328.2, 1)
+}
+
+func TestLocation_Destination(t *testing.T) {
+	dest := paris.Destination(paris.InitialBearing(london), paris.HaversineDistance(london))
+	assertNear(t, dest.Lat, london.Lat, 1e-6)
+	assertNear(t, dest.Lon, london.Lon, 1e-6)
+
+	east := LatLon(0, 179.9).Destination(90, 50_000)
+	if east.Lon > -179 || east.Lon < -180 {
+		t.Errorf("expected longitude to wrap across the antimeridian, got %v", east.Lon)
+	}
+}
+
+func TestLocation_MidpointAndInterpolate(t *testing.T) {
+	mid := LatLon(0, 0).Midpoint(LatLon(0, 10))
+	assertNear(t, mid.Lat, 0, 1e-9)
+	assertNear(t, mid.Lon, 5, 1e-9)
+
+	start := paris.Interpolate(london, 0)
+	assertNear(t, start.Lat, paris.Lat, 1e-9)
+	end := paris.Interpolate(london, 1)
+	assertNear(t, end.Lon, london.Lon, 1e-9)
+
+	quarter := paris.Interpolate(london, 0.25)
+	assertNear(t, paris.HaversineDistance(quarter), paris.HaversineDistance(london)/4, 1)
+}
+
+func TestLocation_InterpolateAntipodal(t *testing.T) {
+	mid := LatLon(0, 0).Midpoint(LatLon(0, 180))
+	assertNear(t, mid.Lat, 90, 1e-9)
+
+	from, to := LatLon(10, 20), LatLon(-10, -160)
+	half := math.Pi * earthRadius
+	for _, f := range []float64{0, 0.25, 0.5, 1} {
+		p := from.Interpolate(to, f)
+		if math.IsNaN(p.Lat) || math.IsNaN(p.Lon) {
+			t.Fatalf("fraction %v: got %v", f, p)
+		}
+		assertNear(t, from.HaversineDistance(p), f*half, 1)
+	}
+}
+
+func TestLocation_BoundingBox(t *testing.T) {
+	box := paris.BoundingBox(10_000)
+	for _, bearing := range []float64{0, 45, 90, 135, 180, 225, 270, 315} {
+		if !box.Contains(paris.Destination(bearing, 9_999)) {
+			t.Errorf("box does not contain point at bearing %v", bearing)
+		}
+	}
+	if box.Contains(paris.Destination(0, 10_100)) {
+		t.Error("box contains point outside the radius")
+	}
+	assertNear(t, box.Center().Lat, paris.Lat, 1e-9)
+	assertNear(t, box.Center().Lon, paris.Lon, 1e-9)
+	assertNoError(t, box.Filter().Err())
+
+	wrapped := LatLon(10, 179.95).BoundingBox(20_000)
+	if wrapped.MinLon <= wrapped.MaxLon {
+		t.Errorf("expected antimeridian box, got %+v", wrapped)
+	}
+	if !wrapped.Contains(LatLon(10, -179.95)) {
+		t.Error("antimeridian box does not contain point across the line")
+	}
+	if err := wrapped.Filter().Err(); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for antimeridian filter, got %v", err)
+	}
+	if err := wrapped.Bias().Err(); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for antimeridian bias, got %v", err)
+	}
+	assertNoError(t, box.Bias().Err())
+
+	polar := LatLon(89.99, 0).BoundingBox(5_000)
+	assertEqual(t, polar.MinLon, -180.0)
+	assertEqual(t, polar.MaxLon, 180.0)
+	assertEqual(t, polar.MaxLat, 90.0)
+}
+
+func TestLocation_FilterHelpers(t *testing.T) {
+	assertEqual(t, paris.CircleFilter(500).String(), CircleFilter(2.3522, 48.8566, 500).String())
+	assertEqual(t, paris.ProximityBias().String(), "proximity:2.3522,48.8566")
+}
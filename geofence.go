@@ -0,0 +1,249 @@
+package geoapify
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+)
+
+// Contains reports whether the location lies inside the polygon and outside
+// all of its holes.
+func (p Polygon) Contains(loc Location) bool {
+	if len(p) == 0 || !ringContains(p[0], loc) {
+		return false
+	}
+	for _, hole := range p[1:] {
+		if ringContains(hole, loc) {
+			return false
+		}
+	}
+	return true
+}
+
+// Contains reports whether the location lies inside any of the polygons.
+func (m MultiPolygon) Contains(loc Location) bool {
+	for _, p := range m {
+		if p.Contains(loc) {
+			return true
+		}
+	}
+	return false
+}
+
+// BBox returns the bounding box of the polygon's outer ring.
+func (p Polygon) BBox() BBox {
+	if len(p) == 0 {
+		return BBox{}
+	}
+	return ringBBox(p[0])
+}
+
+// BBox returns the bounding box of all polygons.
+func (m MultiPolygon) BBox() BBox {
+	var box BBox
+	for i, p := range m {
+		b := p.BBox()
+		if i == 0 {
+			box = b
+			continue
+		}
+		box.MinLon = math.Min(box.MinLon, b.MinLon)
+		box.MinLat = math.Min(box.MinLat, b.MinLat)
+		box.MaxLon = math.Max(box.MaxLon, b.MaxLon)
+		box.MaxLat = math.Max(box.MaxLat, b.MaxLat)
+	}
+	return box
+}
+
+// ringContains implements the even-odd rule by casting a ray towards
+// increasing longitude.
+func ringContains(ring []Location, loc Location) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Lat > loc.Lat) != (b.Lat > loc.Lat) &&
+			loc.Lon < (b.Lon-a.Lon)*(loc.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lon {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func ringBBox(ring []Location) BBox {
+	if len(ring) == 0 {
+		return BBox{}
+	}
+	box := BBox{MinLon: ring[0].Lon, MinLat: ring[0].Lat, MaxLon: ring[0].Lon, MaxLat: ring[0].Lat}
+	for _, c := range ring[1:] {
+		box.MinLon = math.Min(box.MinLon, c.Lon)
+		box.MinLat = math.Min(box.MinLat, c.Lat)
+		box.MaxLon = math.Max(box.MaxLon, c.Lon)
+		box.MaxLat = math.Max(box.MaxLat, c.Lat)
+	}
+	return box
+}
+
+// Fence is a single polygonal area in a Geofence.
+type Fence struct {
+	// ID is taken from the "id" or "place_id" feature property, falling back
+	// to the feature index.
+	ID string
+	// Index is the position of the fence in the input: the argument
+	// position for NewGeofence, the feature index for
+	// NewGeofenceFromFeatures.
+	Index      int
+	Geometry   MultiPolygon
+	Properties map[string]any
+	bbox       BBox
+}
+
+// Geofence is an immutable set of fences indexed for fast point queries.
+// It is safe for concurrent use.
+type Geofence struct {
+	fences   []Fence
+	cellSize float64
+	grid     map[[2]int][]int
+	// oversized holds fences spanning too many cells to index; they are
+	// checked on every query.
+	oversized []int
+}
+
+// Bounds on the grid cell size in degrees, and on the number of cells a
+// fence is indexed in.
+const (
+	minGeofenceCell       = 0.01
+	maxGeofenceCell       = 10.0
+	maxGeofenceFenceCells = 1024
+)
+
+// NewGeofence indexes the given fences.
+func NewGeofence(fences ...Fence) *Geofence {
+	indexed := make([]Fence, len(fences))
+	for i, f := range fences {
+		f.Index = i
+		indexed[i] = f
+	}
+	return newGeofence(indexed)
+}
+
+// newGeofence indexes fences whose Index is already set.
+func newGeofence(fences []Fence) *Geofence {
+	g := &Geofence{
+		fences: make([]Fence, 0, len(fences)),
+		grid:   make(map[[2]int][]int),
+	}
+
+	// Size grid cells after the average fence so that each fence covers
+	// only a few cells.
+	var sum float64
+	for _, f := range fences {
+		f.bbox = f.Geometry.BBox()
+		sum += math.Max(f.bbox.MaxLon-f.bbox.MinLon, f.bbox.MaxLat-f.bbox.MinLat)
+		g.fences = append(g.fences, f)
+	}
+	g.cellSize = maxGeofenceCell
+	if len(fences) > 0 {
+		g.cellSize = math.Min(math.Max(sum/float64(len(fences)), minGeofenceCell), maxGeofenceCell)
+	}
+
+	for i, f := range g.fences {
+		x0, y0 := g.cell(LatLon(f.bbox.MinLat, f.bbox.MinLon))
+		x1, y1 := g.cell(LatLon(f.bbox.MaxLat, f.bbox.MaxLon))
+		if (x1-x0+1)*(y1-y0+1) > maxGeofenceFenceCells {
+			g.oversized = append(g.oversized, i)
+			continue
+		}
+		for x := x0; x <= x1; x++ {
+			for y := y0; y <= y1; y++ {
+				key := [2]int{x, y}
+				g.grid[key] = append(g.grid[key], i)
+			}
+		}
+	}
+	return g
+}
+
+// NewGeofenceFromFeatures builds a geofence from the Polygon and
+// MultiPolygon features of a collection, such as an isolines or boundaries
+// response. Features with other geometry types are skipped.
+func NewGeofenceFromFeatures(fc *GeoJSONFeatureCollection) (*Geofence, error) {
+	var fences []Fence
+	for i, f := range fc.Features {
+		geometry, err := f.Geometry.Polygons()
+		if err != nil {
+			return nil, fmt.Errorf("feature %d: %w", i, err)
+		}
+		if len(geometry) == 0 {
+			continue
+		}
+		fences = append(fences, Fence{
+			ID:         featureID(f, i),
+			Index:      i,
+			Geometry:   geometry,
+			Properties: f.Properties,
+		})
+	}
+	return newGeofence(fences), nil
+}
+
+func featureID(f GeoJSONFeature, index int) string {
+	for _, key := range []string{"id", "place_id"} {
+		if v, ok := f.Properties[key].(string); ok && v != "" {
+			return v
+		}
+	}
+	return strconv.Itoa(index)
+}
+
+func (g *Geofence) cell(loc Location) (int, int) {
+	return int(math.Floor(loc.Lon / g.cellSize)), int(math.Floor(loc.Lat / g.cellSize))
+}
+
+// Len returns the number of fences.
+func (g *Geofence) Len() int {
+	return len(g.fences)
+}
+
+// Fences returns a copy of all fences in input order.
+func (g *Geofence) Fences() []Fence {
+	return slices.Clone(g.fences)
+}
+
+// Containing returns the fences that contain the location, in input order.
+func (g *Geofence) Containing(loc Location) []Fence {
+	var out []Fence
+	for _, i := range g.candidates(loc) {
+		f := g.fences[i]
+		if f.bbox.Contains(loc) && f.Geometry.Contains(loc) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// Contains reports whether any fence contains the location.
+func (g *Geofence) Contains(loc Location) bool {
+	for _, i := range g.candidates(loc) {
+		f := g.fences[i]
+		if f.bbox.Contains(loc) && f.Geometry.Contains(loc) {
+			return true
+		}
+	}
+	return false
+}
+
+// candidates returns the indexes of the fences that may contain the
+// location, in input order.
+func (g *Geofence) candidates(loc Location) []int {
+	x, y := g.cell(loc)
+	indexed := g.grid[[2]int{x, y}]
+	if len(g.oversized) == 0 {
+		return indexed
+	}
+	out := make([]int, 0, len(indexed)+len(g.oversized))
+	out = append(out, indexed...)
+	out = append(out, g.oversized...)
+	slices.Sort(out)
+	return out
+}
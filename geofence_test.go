@@ -0,0 +1,140 @@
+package geoapify
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// square returns a closed ring for the square [lon0,lon1]x[lat0,lat1].
+func square(lon0, lat0, lon1, lat1 float64) []Location {
+	return []Location{
+		LonLat(lon0, lat0), LonLat(lon1, lat0), LonLat(lon1, lat1), LonLat(lon0, lat1), LonLat(lon0, lat0),
+	}
+}
+
+func TestPolygon_Contains(t *testing.T) {
+	donut := Polygon{square(0, 0, 10, 10), square(4, 4, 6, 6)}
+
+	assertEqual(t, donut.Contains(LonLat(2, 2)), true)
+	assertEqual(t, donut.Contains(LonLat(5, 5)), false)
+	assertEqual(t, donut.Contains(LonLat(11, 5)), false)
+	assertEqual(t, donut.Contains(LonLat(5, -1)), false)
+	assertEqual(t, Polygon{}.Contains(LonLat(0, 0)), false)
+
+	multi := MultiPolygon{donut, Polygon{square(20, 20, 30, 30)}}
+	assertEqual(t, multi.Contains(LonLat(25, 25)), true)
+	assertEqual(t, multi.Contains(LonLat(15, 15)), false)
+	assertEqual(t, multi.BBox(), BBox{MinLon: 0, MinLat: 0, MaxLon: 30, MaxLat: 30})
+}
+
+func TestGeofence_Containing(t *testing.T) {
+	g := NewGeofence(
+		Fence{ID: "a", Geometry: MultiPolygon{{square(0, 0, 10, 10)}}},
+		Fence{ID: "b", Geometry: MultiPolygon{{square(5, 5, 15, 15)}}},
+		Fence{ID: "c", Geometry: MultiPolygon{{square(100, 40, 100.5, 40.5)}}},
+	)
+	assertEqual(t, g.Len(), 3)
+
+	got := g.Containing(LonLat(7, 7))
+	assertEqual(t, len(got), 2)
+	assertEqual(t, got[0].ID, "a")
+	assertEqual(t, got[1].ID, "b")
+
+	got = g.Containing(LonLat(100.2, 40.2))
+	assertEqual(t, len(got), 1)
+	assertEqual(t, got[0].ID, "c")
+	assertEqual(t, got[0].Index, 2)
+
+	assertEqual(t, len(g.Containing(LonLat(50, 50))), 0)
+	assertEqual(t, g.Contains(LonLat(12, 12)), true)
+	assertEqual(t, g.Contains(LonLat(-1, -1)), false)
+}
+
+func TestGeofence_MixedSizes(t *testing.T) {
+	// Many tiny fences shrink the grid cells; the world-sized fence must not
+	// be indexed in every one of them.
+	var fences []Fence
+	for i := range 1000 {
+		lon := float64(i) * 0.1
+		fences = append(fences, Fence{ID: strconv.Itoa(i), Geometry: MultiPolygon{{square(lon, 0, lon+0.01, 0.01)}}})
+	}
+	world := Fence{ID: "world", Geometry: MultiPolygon{{square(-180, -85, 180, 85)}}}
+	fences = slices.Insert(fences, 1, world)
+	g := NewGeofence(fences...)
+
+	cells := 0
+	for _, indexes := range g.grid {
+		cells += len(indexes)
+	}
+	if cells > 2*len(fences) {
+		t.Fatalf("grid holds %d entries for %d fences", cells, len(fences))
+	}
+
+	got := g.Containing(LonLat(0.005, 0.005))
+	assertEqual(t, len(got), 2)
+	assertEqual(t, got[0].ID, "0")
+	assertEqual(t, got[1].ID, "world")
+
+	got = g.Containing(LonLat(50.005, 0.005))
+	assertEqual(t, len(got), 2)
+	assertEqual(t, got[0].ID, "world")
+	assertEqual(t, got[1].ID, "500")
+
+	got = g.Containing(LonLat(-120, 30))
+	assertEqual(t, len(got), 1)
+	assertEqual(t, got[0].Index, 1)
+	assertEqual(t, g.Contains(LonLat(0, 89)), false)
+}
+
+func TestGeofence_FromIsolines(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"type":"FeatureCollection","features":[
+			{"type":"Feature","properties":{"id":"iso-300","range":300},
+			 "geometry":{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,1],[0,0]]]]}},
+			{"type":"Feature","properties":{"id":"iso-600","range":600},
+			 "geometry":{"type":"Polygon","coordinates":[[[-1,-1],[2,-1],[2,2],[-1,2],[-1,-1]],[[1.5,1.5],[1.8,1.5],[1.8,1.8],[1.5,1.8],[1.5,1.5]]]}},
+			{"type":"Feature","properties":{"place_id":"pt"},"geometry":{"type":"Point","coordinates":[0,0]}}
+		]}`))
+	})
+
+	resp, err := client.Isolines().At(0.5, 0.5).WithRange(300, 600).Do(context.Background())
+	assertNoError(t, err)
+
+	g, err := NewGeofenceFromFeatures(&resp.GeoJSONFeatureCollection)
+	assertNoError(t, err)
+	assertEqual(t, g.Len(), 2)
+
+	got := g.Containing(LonLat(0.5, 0.5))
+	assertEqual(t, len(got), 2)
+	assertEqual(t, got[0].ID, "iso-300")
+	assertEqual(t, got[1].Properties["range"], any(600.0))
+
+	got = g.Containing(LonLat(1.6, 1.6))
+	assertEqual(t, len(got), 0)
+	got = g.Containing(LonLat(1.2, 1.2))
+	assertEqual(t, len(got), 1)
+	assertEqual(t, got[0].ID, "iso-600")
+}
+
+func TestNewGeofenceFromFeatures_SkippedFeatures(t *testing.T) {
+	square := &GeoJSONGeometry{Type: "Polygon", Coordinates: [][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}}
+	fc := &GeoJSONFeatureCollection{Features: []GeoJSONFeature{
+		{Geometry: &GeoJSONGeometry{Type: "Point", Coordinates: []float64{0, 0}}},
+		{Geometry: square},
+	}}
+
+	g, err := NewGeofenceFromFeatures(fc)
+	assertNoError(t, err)
+	fences := g.Fences()
+	assertEqual(t, len(fences), 1)
+	assertEqual(t, fences[0].ID, "1")
+	assertEqual(t, fences[0].Index, 1)
+
+	// Fences returns a copy, so the index cannot be changed through it.
+	fences[0].ID = "changed"
+	assertEqual(t, g.Fences()[0].ID, "1")
+	assertEqual(t, g.Containing(LonLat(0.5, 0.5))[0].ID, "1")
+}
@@ -0,0 +1,509 @@
+package geoapify
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+)
+
+// Area returns the geodesic area of the polygon in square meters, excluding
+// its holes.
+func (p Polygon) Area() float64 {
+	if len(p) == 0 {
+		return 0
+	}
+	area := math.Abs(ringArea(p[0]))
+	for _, hole := range p[1:] {
+		area -= math.Abs(ringArea(hole))
+	}
+	return math.Max(area, 0)
+}
+
+// Area returns the total geodesic area of the polygons in square meters.
+func (m MultiPolygon) Area() float64 {
+	var area float64
+	for _, p := range m {
+		area += p.Area()
+	}
+	return area
+}
+
+// Perimeter returns the total length of the polygon's rings, including holes,
+// in meters.
+func (p Polygon) Perimeter() float64 {
+	var length float64
+	for _, ring := range p {
+		length += lineLength(ring)
+	}
+	return length
+}
+
+// Perimeter returns the total perimeter of the polygons in meters.
+func (m MultiPolygon) Perimeter() float64 {
+	var length float64
+	for _, p := range m {
+		length += p.Perimeter()
+	}
+	return length
+}
+
+// Centroid returns the area-weighted center of the polygon. It is computed in
+// lon/lat space, which is accurate for areas up to a few hundred kilometers
+// across.
+func (p Polygon) Centroid() Location {
+	var cx, cy, total float64
+	for i, ring := range p {
+		x, y, a := ringCentroid(ring)
+		if i == 0 {
+			a = math.Abs(a)
+		} else {
+			a = -math.Abs(a)
+		}
+		cx += x * a
+		cy += y * a
+		total += a
+	}
+	if total == 0 {
+		if len(p) == 0 || len(p[0]) == 0 {
+			return Location{}
+		}
+		return ringBBox(p[0]).Center()
+	}
+	return LonLat(cx/total, cy/total)
+}
+
+// Centroid returns the area-weighted center of the polygons.
+func (m MultiPolygon) Centroid() Location {
+	var cx, cy, total float64
+	for _, p := range m {
+		c := p.Centroid()
+		a := p.Area()
+		cx += c.Lon * a
+		cy += c.Lat * a
+		total += a
+	}
+	if total == 0 {
+		if len(m) == 0 {
+			return Location{}
+		}
+		return m[0].Centroid()
+	}
+	return LonLat(cx/total, cy/total)
+}
+
+// ringArea returns the signed spherical area of a ring in square meters.
+func ringArea(ring []Location) float64 {
+	n := len(ring)
+	if n < 3 {
+		return 0
+	}
+	var total float64
+	for i := range n {
+		a := ring[i]
+		b := ring[(i+1)%n]
+		total += toRadians(b.Lon-a.Lon) * (2 + math.Sin(toRadians(a.Lat)) + math.Sin(toRadians(b.Lat)))
+	}
+	return total * earthRadius * earthRadius / 2
+}
+
+// ringCentroid returns the planar centroid and signed area of a ring.
+func ringCentroid(ring []Location) (x, y, area float64) {
+	n := len(ring)
+	for i := range n {
+		a := ring[i]
+		b := ring[(i+1)%n]
+		cross := a.Lon*b.Lat - b.Lon*a.Lat
+		area += cross
+		x += (a.Lon + b.Lon) * cross
+		y += (a.Lat + b.Lat) * cross
+	}
+	area /= 2
+	if area == 0 {
+		return 0, 0, 0
+	}
+	return x / (6 * area), y / (6 * area), area
+}
+
+func lineLength(line []Location) float64 {
+	var length float64
+	for i := 1; i < len(line); i++ {
+		length += line[i-1].HaversineDistance(line[i])
+	}
+	return length
+}
+
+// SimplifyMethod selects the line simplification algorithm.
+type SimplifyMethod int
+
+const (
+	// SimplifyDouglasPeucker removes points closer than the tolerance to the
+	// simplified line.
+	SimplifyDouglasPeucker SimplifyMethod = iota
+	// SimplifyVisvalingam repeatedly removes the point forming the smallest
+	// triangle with its neighbors while that area is below the square of the
+	// tolerance.
+	SimplifyVisvalingam
+)
+
+// Simplify returns a simplified copy of the polygon. The tolerance is in
+// meters. Holes that collapse are dropped; the outer ring is kept as is if it
+// would collapse.
+func (p Polygon) Simplify(toleranceMeters float64, method SimplifyMethod) Polygon {
+	out := make(Polygon, 0, len(p))
+	for i, ring := range p {
+		s := simplifyLine(ring, toleranceMeters, method)
+		if len(s) < 4 {
+			if i > 0 {
+				continue
+			}
+			s = ring
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+// Simplify returns a simplified copy of the polygons. The tolerance is in
+// meters.
+func (m MultiPolygon) Simplify(toleranceMeters float64, method SimplifyMethod) MultiPolygon {
+	out := make(MultiPolygon, len(m))
+	for i, p := range m {
+		out[i] = p.Simplify(toleranceMeters, method)
+	}
+	return out
+}
+
+// point is a location projected onto a local plane, in meters.
+type point struct{ x, y float64 }
+
+// project maps locations onto an equirectangular plane centered on the first
+// location.
+func project(line []Location) []point {
+	if len(line) == 0 {
+		return nil
+	}
+	lat0 := toRadians(line[0].Lat)
+	kx := earthRadius * math.Cos(lat0) * math.Pi / 180
+	ky := earthRadius * math.Pi / 180
+	out := make([]point, len(line))
+	for i, l := range line {
+		out[i] = point{x: (l.Lon - line[0].Lon) * kx, y: (l.Lat - line[0].Lat) * ky}
+	}
+	return out
+}
+
+func simplifyLine(line []Location, tolerance float64, method SimplifyMethod) []Location {
+	if len(line) < 3 || tolerance <= 0 {
+		return line
+	}
+	pts := project(line)
+	var keep []bool
+	switch method {
+	case SimplifyVisvalingam:
+		keep = visvalingam(pts, tolerance*tolerance)
+	default:
+		keep = douglasPeucker(pts, tolerance)
+	}
+	out := make([]Location, 0, len(line))
+	for i, k := range keep {
+		if k {
+			out = append(out, line[i])
+		}
+	}
+	return out
+}
+
+func douglasPeucker(pts []point, tolerance float64) []bool {
+	keep := make([]bool, len(pts))
+	keep[0], keep[len(pts)-1] = true, true
+
+	// A closed ring has identical end points; split it at the point farthest
+	// from the start so each half has a proper baseline.
+	type span struct{ first, last int }
+	stack := []span{{0, len(pts) - 1}}
+	if pts[0] == pts[len(pts)-1] {
+		far, best := 0, -1.0
+		for i := 1; i < len(pts)-1; i++ {
+			if d := math.Hypot(pts[i].x-pts[0].x, pts[i].y-pts[0].y); d > best {
+				far, best = i, d
+			}
+		}
+		keep[far] = true
+		stack = []span{{0, far}, {far, len(pts) - 1}}
+	}
+
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		idx, maxDist := -1, tolerance
+		for i := s.first + 1; i < s.last; i++ {
+			if d := segmentDistance(pts[i], pts[s.first], pts[s.last]); d > maxDist {
+				idx, maxDist = i, d
+			}
+		}
+		if idx >= 0 {
+			keep[idx] = true
+			stack = append(stack, span{s.first, idx}, span{idx, s.last})
+		}
+	}
+	return keep
+}
+
+// segmentDistance returns the distance from p to the segment ab.
+func segmentDistance(p, a, b point) float64 {
+	dx, dy := b.x-a.x, b.y-a.y
+	if dx == 0 && dy == 0 {
+		return math.Hypot(p.x-a.x, p.y-a.y)
+	}
+	t := ((p.x-a.x)*dx + (p.y-a.y)*dy) / (dx*dx + dy*dy)
+	t = math.Max(0, math.Min(1, t))
+	return math.Hypot(p.x-(a.x+t*dx), p.y-(a.y+t*dy))
+}
+
+func triangleArea(a, b, c point) float64 {
+	return math.Abs((b.x-a.x)*(c.y-a.y)-(c.x-a.x)*(b.y-a.y)) / 2
+}
+
+type vwItem struct {
+	index int
+	area  float64
+	pos   int
+}
+
+type vwHeap []*vwItem
+
+func (h vwHeap) Len() int           { return len(h) }
+func (h vwHeap) Less(i, j int) bool { return h[i].area < h[j].area }
+func (h vwHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].pos = i
+	h[j].pos = j
+}
+func (h *vwHeap) Push(x any) {
+	item := x.(*vwItem)
+	item.pos = len(*h)
+	*h = append(*h, item)
+}
+func (h *vwHeap) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
+
+func visvalingam(pts []point, minArea float64) []bool {
+	n := len(pts)
+	keep := make([]bool, n)
+	prev := make([]int, n)
+	next := make([]int, n)
+	items := make([]*vwItem, n)
+	h := make(vwHeap, 0, n)
+	for i := range n {
+		keep[i] = true
+		prev[i], next[i] = i-1, i+1
+		if i > 0 && i < n-1 {
+			items[i] = &vwItem{index: i, area: triangleArea(pts[i-1], pts[i], pts[i+1])}
+			heap.Push(&h, items[i])
+		}
+	}
+
+	var last float64
+	for h.Len() > 0 {
+		item := heap.Pop(&h).(*vwItem)
+		// Never let an area drop below that of an already removed point, so
+		// removal order stays monotonic.
+		area := math.Max(item.area, last)
+		if area >= minArea {
+			break
+		}
+		last = area
+		i := item.index
+		keep[i] = false
+		items[i] = nil
+		p, nx := prev[i], next[i]
+		next[p], prev[nx] = nx, p
+		for _, j := range []int{p, nx} {
+			if items[j] == nil {
+				continue
+			}
+			items[j].area = triangleArea(pts[prev[j]], pts[j], pts[next[j]])
+			heap.Fix(&h, items[j].pos)
+		}
+	}
+	return keep
+}
+
+// BBox returns the bounding box of the feature's geometry.
+func (f *GeoJSONFeature) BBox() (BBox, error) {
+	positions, err := f.Geometry.positions()
+	if err != nil {
+		return BBox{}, err
+	}
+	return ringBBox(positions), nil
+}
+
+// Area returns the geodesic area of a Polygon or MultiPolygon feature in
+// square meters. Other geometry types have zero area.
+func (f *GeoJSONFeature) Area() (float64, error) {
+	m, err := f.Geometry.Polygons()
+	if err != nil {
+		return 0, err
+	}
+	return m.Area(), nil
+}
+
+// Perimeter returns the perimeter of a Polygon or MultiPolygon feature in
+// meters.
+func (f *GeoJSONFeature) Perimeter() (float64, error) {
+	m, err := f.Geometry.Polygons()
+	if err != nil {
+		return 0, err
+	}
+	return m.Perimeter(), nil
+}
+
+// Centroid returns the centroid of a Polygon or MultiPolygon feature, or the
+// center of the bounding box for other geometry types.
+func (f *GeoJSONFeature) Centroid() (Location, error) {
+	m, err := f.Geometry.Polygons()
+	if err != nil {
+		return Location{}, err
+	}
+	if len(m) > 0 {
+		return m.Centroid(), nil
+	}
+	box, err := f.BBox()
+	if err != nil {
+		return Location{}, err
+	}
+	return box.Center(), nil
+}
+
+// Simplify returns a copy of the feature with its line or polygon geometry
+// simplified. The tolerance is in meters. Point geometries are unchanged.
+func (f *GeoJSONFeature) Simplify(toleranceMeters float64, method SimplifyMethod) (GeoJSONFeature, error) {
+	out := *f
+	if f.Geometry == nil {
+		return out, nil
+	}
+
+	var coords any
+	switch f.Geometry.Type {
+	case "LineString":
+		var line [][]float64
+		if err := remarshal(f.Geometry.Coordinates, &line); err != nil {
+			return out, fmt.Errorf("decoding line: %w", err)
+		}
+		coords = fromLocations(simplifyLine(toLocations(line), toleranceMeters, method))
+	case "MultiLineString":
+		var lines [][][]float64
+		if err := remarshal(f.Geometry.Coordinates, &lines); err != nil {
+			return out, fmt.Errorf("decoding lines: %w", err)
+		}
+		s := make([][][2]float64, len(lines))
+		for i, line := range lines {
+			s[i] = fromLocations(simplifyLine(toLocations(line), toleranceMeters, method))
+		}
+		coords = s
+	case "Polygon", "MultiPolygon":
+		m, err := f.Geometry.Polygons()
+		if err != nil {
+			return out, err
+		}
+		m = m.Simplify(toleranceMeters, method)
+		if f.Geometry.Type == "Polygon" {
+			coords = fromPolygon(m[0])
+		} else {
+			s := make([][][][2]float64, len(m))
+			for i, p := range m {
+				s[i] = fromPolygon(p)
+			}
+			coords = s
+		}
+	default:
+		return out, nil
+	}
+	out.Geometry = &GeoJSONGeometry{Type: f.Geometry.Type, Coordinates: coords}
+	return out, nil
+}
+
+// BBox returns the bounding box of all features in the collection.
+func (fc *GeoJSONFeatureCollection) BBox() (BBox, error) {
+	var all []Location
+	for _, f := range fc.Features {
+		positions, err := f.Geometry.positions()
+		if err != nil {
+			return BBox{}, err
+		}
+		all = append(all, positions...)
+	}
+	return ringBBox(all), nil
+}
+
+// Simplify returns a copy of the collection with every feature simplified.
+// The tolerance is in meters.
+func (fc *GeoJSONFeatureCollection) Simplify(toleranceMeters float64, method SimplifyMethod) (*GeoJSONFeatureCollection, error) {
+	out := &GeoJSONFeatureCollection{
+		Type:       fc.Type,
+		Features:   make([]GeoJSONFeature, len(fc.Features)),
+		Properties: fc.Properties,
+	}
+	for i := range fc.Features {
+		f, err := fc.Features[i].Simplify(toleranceMeters, method)
+		if err != nil {
+			return nil, fmt.Errorf("feature %d: %w", i, err)
+		}
+		out.Features[i] = f
+	}
+	return out, nil
+}
+
+// positions returns every position of the geometry, regardless of its type.
+func (g *GeoJSONGeometry) positions() ([]Location, error) {
+	if g == nil {
+		return nil, nil
+	}
+	var coords any
+	if err := remarshal(g.Coordinates, &coords); err != nil {
+		return nil, fmt.Errorf("decoding geometry: %w", err)
+	}
+	var out []Location
+	var walk func(v any)
+	walk = func(v any) {
+		arr, ok := v.([]any)
+		if !ok || len(arr) == 0 {
+			return
+		}
+		if lon, ok := arr[0].(float64); ok {
+			if len(arr) < 2 {
+				return
+			}
+			if lat, ok := arr[1].(float64); ok {
+				out = append(out, LonLat(lon, lat))
+			}
+			return
+		}
+		for _, c := range arr {
+			walk(c)
+		}
+	}
+	walk(coords)
+	return out, nil
+}
+
+// fromLocations converts Locations into GeoJSON [lon, lat] positions.
+func fromLocations(line []Location) [][2]float64 {
+	out := make([][2]float64, len(line))
+	for i, l := range line {
+		out[i] = [2]float64{l.Lon, l.Lat}
+	}
+	return out
+}
+
+func fromPolygon(p Polygon) [][][2]float64 {
+	out := make([][][2]float64, len(p))
+	for i, ring := range p {
+		out[i] = fromLocations(ring)
+	}
+	return out
+}
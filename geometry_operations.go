@@ -0,0 +1,103 @@
+package geoapify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// GeometryService provides access to the GeoApify Geometry Operations API,
+// which combines geometries stored by other APIs, such as isolines.
+type GeometryService struct {
+	client *Client
+}
+
+// GeometryOperation represents a geometry operation.
+type GeometryOperation string
+
+const (
+	GeometryUnion        GeometryOperation = "union"
+	GeometryIntersection GeometryOperation = "intersection"
+)
+
+// Union creates a request builder for the union of the geometries with the
+// given IDs.
+func (s *GeometryService) Union(ids ...string) *GeometryOperationRequest {
+	return &GeometryOperationRequest{
+		service:   s,
+		operation: GeometryUnion,
+		ids:       ids,
+	}
+}
+
+// Intersection creates a request builder for the intersection of the
+// geometries with the given IDs.
+func (s *GeometryService) Intersection(ids ...string) *GeometryOperationRequest {
+	return &GeometryOperationRequest{
+		service:   s,
+		operation: GeometryIntersection,
+		ids:       ids,
+	}
+}
+
+// GeometryOperationRequest is a builder for geometry operation API requests.
+type GeometryOperationRequest struct {
+	service   *GeometryService
+	operation GeometryOperation
+	ids       []string
+}
+
+// Do executes the geometry operation request.
+func (r *GeometryOperationRequest) Do(ctx context.Context) (*GeometryOperationResponse, error) {
+	if len(r.ids) < 2 {
+		return nil, fmt.Errorf("%w: %s needs at least two geometry IDs", ErrInvalidArgument, r.operation)
+	}
+
+	body := geometryOperationBody{ID: r.ids}
+
+	var result GeometryOperationResponse
+	if err := r.service.client.doPost(ctx, "/v1/geometry/"+string(r.operation), nil, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+type geometryOperationBody struct {
+	ID []string `json:"id"`
+}
+
+// GeometryOperationResponse is the response from the geometry operations API.
+type GeometryOperationResponse struct {
+	GeoJSONFeatureCollection
+	// ID identifies the resulting geometry, which is stored by the API and
+	// can be used in further operations or in a GeometryFilter.
+	ID string `json:"-"`
+	// Geometry is the resulting area. It is empty when the intersection of
+	// the inputs is empty.
+	Geometry MultiPolygon `json:"-"`
+}
+
+// UnmarshalJSON implements custom unmarshalling for GeometryOperationResponse.
+func (r *GeometryOperationResponse) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.GeoJSONFeatureCollection); err != nil {
+		return err
+	}
+	r.ID, _ = r.Properties["id"].(string)
+	r.Geometry = nil
+	for _, f := range r.Features {
+		if r.ID == "" {
+			r.ID, _ = f.Properties["id"].(string)
+		}
+		m, err := f.Geometry.Polygons()
+		if err != nil {
+			return err
+		}
+		r.Geometry = append(r.Geometry, m...)
+	}
+	return nil
+}
+
+// Filter returns a filter limiting results to the resulting geometry.
+func (r *GeometryOperationResponse) Filter() Filter {
+	return GeometryFilter(r.ID)
+}
@@ -0,0 +1,84 @@
+package geoapify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+const geometryResultJSON = `{"type":"FeatureCollection","properties":{"id":"geom-42"},"features":[{
+	"type":"Feature","properties":{},
+	"geometry":{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,1],[0,0]]],[[[5,5],[6,5],[6,6],[5,6],[5,5]]]]}
+}]}`
+
+func TestGeometry_Operations(t *testing.T) {
+	tests := []struct {
+		name     string
+		build    func(*GeometryService) *GeometryOperationRequest
+		wantPath string
+	}{
+		{
+			name:     "union",
+			build:    func(s *GeometryService) *GeometryOperationRequest { return s.Union("iso-1", "iso-2") },
+			wantPath: "/v1/geometry/union",
+		},
+		{
+			name:     "intersection",
+			build:    func(s *GeometryService) *GeometryOperationRequest { return s.Intersection("iso-1", "iso-2") },
+			wantPath: "/v1/geometry/intersection",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				assertEqual(t, r.Method, http.MethodPost)
+				assertEqual(t, r.URL.Path, tt.wantPath)
+
+				body, err := io.ReadAll(r.Body)
+				assertNoError(t, err)
+				var b geometryOperationBody
+				assertNoError(t, json.Unmarshal(body, &b))
+				assertEqual(t, len(b.ID), 2)
+				assertEqual(t, b.ID[1], "iso-2")
+
+				w.Write([]byte(geometryResultJSON))
+			})
+
+			got, err := tt.build(client.Geometry()).Do(context.Background())
+			assertNoError(t, err)
+			assertEqual(t, got.ID, "geom-42")
+			assertEqual(t, len(got.Geometry), 2)
+			assertEqual(t, got.Geometry.Contains(LonLat(5.5, 5.5)), true)
+			assertEqual(t, got.Filter().String(), "geometry:geom-42")
+		})
+	}
+}
+
+func TestGeometry_RequiresTwoIDs(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	})
+
+	_, err := client.Geometry().Union("only-one").Do(context.Background())
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument, got %v", err)
+	}
+}
+
+func TestGeometry_APIError(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"Geometry not found"}`))
+	})
+
+	_, err := client.Geometry().Intersection("a", "b").Do(context.Background())
+	apiErr, ok := IsAPIError(err)
+	if !ok {
+		t.Fatal("expected APIError")
+	}
+	assertEqual(t, apiErr.StatusCode, 404)
+}
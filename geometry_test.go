@@ -0,0 +1,116 @@
+package geoapify
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPolygon_AreaAndPerimeter(t *testing.T) {
+	// A one-degree square at the equator is roughly 111.2km on each side.
+	sq := Polygon{square(0, 0, 1, 1)}
+	assertNear(t, sq.Area(), 12_364e6, 20e6)
+	assertNear(t, sq.Perimeter(), 4*111_195, 200)
+
+	withHole := Polygon{square(0, 0, 1, 1), square(0.25, 0.25, 0.75, 0.75)}
+	assertNear(t, withHole.Area(), sq.Area()*0.75, 10e6)
+
+	multi := MultiPolygon{sq, Polygon{square(10, 0, 11, 1)}}
+	assertNear(t, multi.Area(), 2*sq.Area(), 1e6)
+}
+
+func TestPolygon_Centroid(t *testing.T) {
+	c := Polygon{square(0, 0, 2, 2)}.Centroid()
+	assertNear(t, c.Lon, 1, 1e-9)
+	assertNear(t, c.Lat, 1, 1e-9)
+
+	// Removing the right half as a hole shifts the centroid left.
+	c = Polygon{square(0, 0, 4, 2), square(2, 0, 4, 2)}.Centroid()
+	assertNear(t, c.Lon, 1, 1e-9)
+
+	c = MultiPolygon{{square(0, 0, 1, 1)}, {square(10, 0, 11, 1)}}.Centroid()
+	assertNear(t, c.Lon, 5.5, 1e-3)
+}
+
+// wavyRing returns a closed, roughly circular ring with small zigzags.
+func wavyRing(n int) []Location {
+	ring := make([]Location, 0, n+1)
+	for i := range n {
+		a := 2 * math.Pi * float64(i) / float64(n)
+		r := 0.01 + 0.00001*float64(i%2)
+		ring = append(ring, LonLat(r*math.Cos(a), r*math.Sin(a)))
+	}
+	return append(ring, ring[0])
+}
+
+func TestPolygon_Simplify(t *testing.T) {
+	p := Polygon{wavyRing(1000)}
+	for _, method := range []SimplifyMethod{SimplifyDouglasPeucker, SimplifyVisvalingam} {
+		s := p.Simplify(10, method)
+		if len(s[0]) >= len(p[0])/4 {
+			t.Errorf("method %d: expected far fewer points, got %d", method, len(s[0]))
+		}
+		if len(s[0]) < 4 {
+			t.Errorf("method %d: ring collapsed to %d points", method, len(s[0]))
+		}
+		assertEqual(t, s[0][0], s[0][len(s[0])-1])
+		if math.Abs(s.Area()-p.Area())/p.Area() > 0.02 {
+			t.Errorf("method %d: area changed from %v to %v", method, p.Area(), s.Area())
+		}
+	}
+
+	// Tiny holes collapse and are dropped, the outer ring never collapses.
+	tiny := Polygon{square(0, 0, 0.0001, 0.0001), square(0.00002, 0.00002, 0.00003, 0.00003)}
+	s := tiny.Simplify(1000, SimplifyDouglasPeucker)
+	assertEqual(t, len(s), 1)
+	assertEqual(t, len(s[0]), 5)
+}
+
+func TestFeature_GeometryUtilities(t *testing.T) {
+	fc := GeoJSONFeatureCollection{
+		Type: "FeatureCollection",
+		Features: []GeoJSONFeature{
+			{Type: "Feature", Geometry: &GeoJSONGeometry{Type: "Polygon", Coordinates: fromPolygon(Polygon{wavyRing(500)})}},
+			{Type: "Feature", Geometry: &GeoJSONGeometry{Type: "Point", Coordinates: []any{5.0, 6.0}}},
+			{Type: "Feature", Geometry: &GeoJSONGeometry{Type: "LineString", Coordinates: fromLocations(wavyRing(100))}},
+		},
+	}
+
+	box, err := fc.BBox()
+	assertNoError(t, err)
+	assertNear(t, box.MaxLon, 5, 1e-9)
+	assertNear(t, box.MaxLat, 6, 1e-9)
+	assertNear(t, box.MinLon, -0.01001, 1e-5)
+
+	area, err := fc.Features[0].Area()
+	assertNoError(t, err)
+	assertNear(t, area, math.Pi*1113*1113, 0.01*area)
+
+	perimeter, err := fc.Features[0].Perimeter()
+	assertNoError(t, err)
+	if perimeter < 2*math.Pi*1110 {
+		t.Errorf("perimeter too small: %v", perimeter)
+	}
+
+	c, err := fc.Features[0].Centroid()
+	assertNoError(t, err)
+	assertNear(t, c.Lon, 0, 1e-4)
+	c, err = fc.Features[1].Centroid()
+	assertNoError(t, err)
+	assertEqual(t, c, LonLat(5, 6))
+
+	simplified, err := fc.Simplify(20, SimplifyVisvalingam)
+	assertNoError(t, err)
+	assertEqual(t, len(simplified.Features), 3)
+	poly, err := simplified.Features[0].Geometry.Polygons()
+	assertNoError(t, err)
+	if len(poly[0][0]) >= 500 {
+		t.Errorf("expected simplified polygon, got %d points", len(poly[0][0]))
+	}
+	assertEqual(t, simplified.Features[1].Geometry.Type, "Point")
+	assertEqual(t, simplified.Features[2].Geometry.Type, "LineString")
+
+	// The input is left untouched.
+	orig, err := fc.Features[0].Geometry.Polygons()
+	assertNoError(t, err)
+	assertEqual(t, len(orig[0][0]), 501)
+}
@@ -0,0 +1,198 @@
+package geoapify
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// IconsService provides access to the GeoApify Marker Icon API.
+type IconsService struct {
+	client *Client
+}
+
+// Icon creates a new marker icon request builder.
+func (s *IconsService) Icon() *IconRequest {
+	return &IconRequest{service: s}
+}
+
+// FromMarker creates a request for the icon a static map marker is drawn
+// with, e.g. to render a map legend.
+func (s *IconsService) FromMarker(m StaticMapMarker) *IconRequest {
+	return &IconRequest{
+		service:      s,
+		markerType:   m.Type,
+		color:        m.Color,
+		size:         m.Size,
+		icon:         m.Icon,
+		iconType:     m.IconType,
+		text:         m.Text,
+		textSize:     m.TextSize,
+		contentColor: m.ContentColor,
+		strokeColor:  m.StrokeColor,
+		shadow:       m.Shadow,
+		shadowColor:  m.ShadowColor,
+		whiteCircle:  m.WhiteCircle,
+	}
+}
+
+// IconRequest is a builder for marker icon API requests.
+type IconRequest struct {
+	service      *IconsService
+	markerType   MarkerType
+	color        string
+	size         MarkerSize
+	icon         string
+	iconType     IconType
+	text         string
+	textSize     MarkerSize
+	contentColor string
+	strokeColor  string
+	shadow       *bool
+	shadowColor  string
+	whiteCircle  *bool
+	scaleFactor  int
+}
+
+// WithType sets the marker shape.
+func (r *IconRequest) WithType(t MarkerType) *IconRequest {
+	r.markerType = t
+	return r
+}
+
+// WithColor sets the marker color, e.g. "#ff0000" or "red".
+func (r *IconRequest) WithColor(color string) *IconRequest {
+	r.color = color
+	return r
+}
+
+// WithSize sets the marker size.
+func (r *IconRequest) WithSize(size MarkerSize) *IconRequest {
+	r.size = size
+	return r
+}
+
+// WithIcon sets the icon drawn inside the marker and the set it comes from.
+func (r *IconRequest) WithIcon(name string, iconType IconType) *IconRequest {
+	r.icon = name
+	r.iconType = iconType
+	return r
+}
+
+// WithText draws text inside the marker instead of an icon.
+func (r *IconRequest) WithText(text string, size MarkerSize) *IconRequest {
+	r.text = text
+	r.textSize = size
+	return r
+}
+
+// WithContentColor sets the color of the icon or text.
+func (r *IconRequest) WithContentColor(color string) *IconRequest {
+	r.contentColor = color
+	return r
+}
+
+// WithStrokeColor sets the marker outline color.
+func (r *IconRequest) WithStrokeColor(color string) *IconRequest {
+	r.strokeColor = color
+	return r
+}
+
+// WithShadow enables or disables the marker shadow.
+func (r *IconRequest) WithShadow(v bool) *IconRequest {
+	r.shadow = &v
+	return r
+}
+
+// WithShadowColor sets the shadow color.
+func (r *IconRequest) WithShadowColor(color string) *IconRequest {
+	r.shadowColor = color
+	return r
+}
+
+// WithWhiteCircle enables or disables the white circle behind the icon.
+func (r *IconRequest) WithWhiteCircle(v bool) *IconRequest {
+	r.whiteCircle = &v
+	return r
+}
+
+// WithScaleFactor sets the pixel density, e.g. 2 for high-DPI screens.
+func (r *IconRequest) WithScaleFactor(n int) *IconRequest {
+	r.scaleFactor = n
+	return r
+}
+
+// Marker returns a static map marker at loc drawn with this icon's style.
+func (r *IconRequest) Marker(loc Location) StaticMapMarker {
+	return StaticMapMarker{
+		Location:     loc,
+		Type:         r.markerType,
+		Color:        r.color,
+		Size:         r.size,
+		Icon:         r.icon,
+		IconType:     r.iconType,
+		Text:         r.text,
+		TextSize:     r.textSize,
+		ContentColor: r.contentColor,
+		StrokeColor:  r.strokeColor,
+		Shadow:       r.shadow,
+		ShadowColor:  r.shadowColor,
+		WhiteCircle:  r.whiteCircle,
+	}
+}
+
+func (r *IconRequest) params() (url.Values, error) {
+	if r.scaleFactor < 0 {
+		return nil, fmt.Errorf("%w: negative scale factor", ErrInvalidArgument)
+	}
+	if r.icon != "" && r.text != "" {
+		return nil, fmt.Errorf("%w: icon and text are mutually exclusive", ErrInvalidArgument)
+	}
+
+	params := url.Values{}
+	set := func(key, value string) {
+		if value != "" {
+			params.Set(key, value)
+		}
+	}
+	set("type", string(r.markerType))
+	set("color", r.color)
+	set("size", string(r.size))
+	set("icon", r.icon)
+	set("iconType", string(r.iconType))
+	set("text", r.text)
+	set("textSize", string(r.textSize))
+	set("contentColor", r.contentColor)
+	set("strokeColor", r.strokeColor)
+	set("shadowColor", r.shadowColor)
+	if r.shadow != nil && !*r.shadow {
+		params.Set("noShadow", "")
+	}
+	if r.whiteCircle != nil && !*r.whiteCircle {
+		params.Set("noWhiteCircle", "")
+	}
+	if r.scaleFactor > 0 {
+		params.Set("scaleFactor", strconv.Itoa(r.scaleFactor))
+	}
+	return params, nil
+}
+
+// URL returns the icon URL, including the API key, for use in <img> tags or
+// map libraries.
+func (r *IconRequest) URL() (string, error) {
+	params, err := r.params()
+	if err != nil {
+		return "", err
+	}
+	return r.service.client.buildURL("/v1/icon", params), nil
+}
+
+// Do executes the request and returns the PNG image.
+func (r *IconRequest) Do(ctx context.Context) ([]byte, error) {
+	u, err := r.URL()
+	if err != nil {
+		return nil, err
+	}
+	return r.service.client.doGetBytes(ctx, u)
+}
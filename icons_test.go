@@ -0,0 +1,88 @@
+package geoapify
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestIcons_Icon(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assertEqual(t, r.Method, http.MethodGet)
+		assertEqual(t, r.URL.Path, "/v1/icon")
+		q := r.URL.Query()
+		assertEqual(t, q.Get("type"), "material")
+		assertEqual(t, q.Get("color"), "#d32f2f")
+		assertEqual(t, q.Get("size"), "large")
+		assertEqual(t, q.Get("icon"), "coffee")
+		assertEqual(t, q.Get("iconType"), "awesome")
+		assertEqual(t, q.Get("contentColor"), "white")
+		assertEqual(t, q.Get("strokeColor"), "black")
+		assertEqual(t, q.Get("scaleFactor"), "2")
+		assertEqual(t, q.Has("noShadow"), true)
+		assertEqual(t, q.Has("noWhiteCircle"), false)
+		assertEqual(t, q.Get("text"), "")
+		w.Header().Set("Content-Type", "image/png")
+		w.Write([]byte("\x89PNG"))
+	})
+
+	png, err := client.Icons().Icon().
+		WithType(MarkerMaterial).
+		WithColor("#d32f2f").
+		WithSize(MarkerLarge).
+		WithIcon("coffee", IconAwesome).
+		WithContentColor("white").
+		WithStrokeColor("black").
+		WithShadow(false).
+		WithScaleFactor(2).
+		Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, string(png), "\x89PNG")
+}
+
+func TestIcons_URL(t *testing.T) {
+	client := NewClient("test-api-key")
+
+	u, err := client.Icons().Icon().
+		WithType(MarkerCircle).
+		WithText("7", MarkerSmall).
+		WithWhiteCircle(false).
+		URL()
+	assertNoError(t, err)
+	assertEqual(t, u, "https://api.geoapify.com/v1/icon?apiKey=test-api-key&noWhiteCircle=&text=7&textSize=small&type=circle")
+}
+
+func TestIcons_Validation(t *testing.T) {
+	client := NewClient("test-api-key")
+
+	_, err := client.Icons().Icon().WithScaleFactor(-1).URL()
+	assertError(t, err)
+
+	_, err = client.Icons().Icon().WithIcon("coffee", IconAwesome).WithText("A", "").URL()
+	assertError(t, err)
+}
+
+func TestIcons_MarkerRoundTrip(t *testing.T) {
+	client := NewClient("test-api-key")
+	loc := LonLat(2.35, 48.85)
+
+	marker := client.Icons().Icon().
+		WithType(MarkerAwesome).
+		WithColor("red").
+		WithIcon("store", IconMaterial).
+		WithShadow(true).
+		Marker(loc)
+	assertEqual(t, marker.Location, loc)
+	assertEqual(t, marker.Type, MarkerAwesome)
+	assertEqual(t, marker.Icon, "store")
+	assertEqual(t, marker.IconType, IconMaterial)
+	assertEqual(t, *marker.Shadow, true)
+
+	enc, err := marker.encode()
+	assertNoError(t, err)
+	assertEqual(t, enc, "lonlat:2.35,48.85;type:awesome;color:red;icon:store;icontype:material;shadow:yes")
+
+	again := client.Icons().FromMarker(marker).Marker(loc)
+	assertEqual(t, again.Color, "red")
+	assertEqual(t, again.Icon, "store")
+}
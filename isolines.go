@@ -2,10 +2,12 @@ package geoapify
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // IsolinesService provides access to the GeoApify Isolines API.
@@ -27,6 +29,7 @@ type IsolineRequest struct {
 	routeType RouteType
 	maxSpeed  int
 	units     Units
+	poll      pollConfig
 }
 
 // At creates a new IsolineRequest for the given coordinates.
@@ -94,8 +97,14 @@ func (r *IsolineRequest) WithUnits(u Units) *IsolineRequest {
 	return r
 }
 
-// Do executes the isoline request.
-func (r *IsolineRequest) Do(ctx context.Context) (*GeoJSONFeatureCollection, error) {
+// WithPollInterval sets how often DoAndWait polls for a pending isoline.
+// The interval doubles after each poll up to maxInterval.
+func (r *IsolineRequest) WithPollInterval(interval, maxInterval time.Duration) *IsolineRequest {
+	r.poll = pollConfig{interval: interval, maxInterval: maxInterval}
+	return r
+}
+
+func (r *IsolineRequest) params() (url.Values, error) {
 	params := url.Values{}
 
 	if r.id != "" {
@@ -133,10 +142,138 @@ func (r *IsolineRequest) Do(ctx context.Context) (*GeoJSONFeatureCollection, err
 	if r.units != "" {
 		params.Set("units", string(r.units))
 	}
+	return params, nil
+}
+
+// Do executes the isoline request. Large isolines may not be ready yet, in
+// which case the response is Pending and can be fetched later with ByID.
+func (r *IsolineRequest) Do(ctx context.Context) (*IsolineResponse, error) {
+	params, err := r.params()
+	if err != nil {
+		return nil, err
+	}
 
-	var result GeoJSONFeatureCollection
+	var result IsolineResponse
 	if err := r.client.doGet(ctx, "/v1/isoline", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
+
+// DoAndWait executes the isoline request and, while the isoline is still
+// being calculated, polls it by ID with backoff until it is ready or the
+// context is done. The first poll happens one interval after the request.
+func (r *IsolineRequest) DoAndWait(ctx context.Context) (*IsolineResponse, error) {
+	result, err := r.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Pending() {
+		return result, nil
+	}
+
+	byID := &IsolineRequest{client: r.client, id: result.ID}
+	err = r.poll.doAfterDelay(ctx, func() (bool, error) {
+		resp, err := byID.Do(ctx)
+		if err != nil {
+			return false, err
+		}
+		result = resp
+		return !resp.Pending(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IsolineResponse is the response from the isolines API.
+type IsolineResponse struct {
+	GeoJSONFeatureCollection
+	// ID identifies the isoline, and can be passed to ByID or used in a
+	// geometry filter.
+	ID string `json:"id,omitempty"`
+	// Status is "pending" while the isoline is still being calculated.
+	Status string `json:"status,omitempty"`
+	// Isolines holds the typed features.
+	Isolines []Isoline `json:"-"`
+}
+
+// Pending reports whether the isoline is still being calculated.
+func (r *IsolineResponse) Pending() bool {
+	return r.Status == "pending" || (len(r.Features) == 0 && r.ID != "")
+}
+
+// UnmarshalJSON implements custom unmarshalling for IsolineResponse.
+func (r *IsolineResponse) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.GeoJSONFeatureCollection); err != nil {
+		return err
+	}
+	var meta struct {
+		ID     string `json:"id"`
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return err
+	}
+	r.ID = meta.ID
+	r.Status = meta.Status
+	if r.ID == "" {
+		if id, ok := r.Properties["id"].(string); ok {
+			r.ID = id
+		}
+	}
+
+	r.Isolines = r.Isolines[:0]
+	for _, f := range r.Features {
+		iso, err := newIsoline(f)
+		if err != nil {
+			return err
+		}
+		if r.ID == "" {
+			r.ID = iso.ID
+		}
+		r.Isolines = append(r.Isolines, iso)
+	}
+	return nil
+}
+
+// Isoline is a single reachability area.
+type Isoline struct {
+	ID string
+	// Range is the time in seconds or the distance in meters.
+	Range    float64
+	Mode     TravelMode
+	Type     IsolineType
+	Origin   Location
+	Geometry MultiPolygon
+	// Properties holds the raw feature properties.
+	Properties map[string]any
+}
+
+func newIsoline(f GeoJSONFeature) (Isoline, error) {
+	var props struct {
+		ID    string      `json:"id"`
+		Range float64     `json:"range"`
+		Mode  TravelMode  `json:"mode"`
+		Type  IsolineType `json:"type"`
+		Lat   float64     `json:"lat"`
+		Lon   float64     `json:"lon"`
+	}
+	if err := remarshal(f.Properties, &props); err != nil {
+		return Isoline{}, fmt.Errorf("decoding isoline properties: %w", err)
+	}
+	geometry, err := f.Geometry.Polygons()
+	if err != nil {
+		return Isoline{}, err
+	}
+	return Isoline{
+		ID:         props.ID,
+		Range:      props.Range,
+		Mode:       props.Mode,
+		Type:       props.Type,
+		Origin:     LatLon(props.Lat, props.Lon),
+		Geometry:   geometry,
+		Properties: f.Properties,
+	}, nil
+}
@@ -2,8 +2,11 @@ package geoapify
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestIsolines(t *testing.T) {
@@ -163,3 +166,83 @@ func TestIsolines_APIError(t *testing.T) {
 	}
 	assertEqual(t, apiErr.StatusCode, 400)
 }
+
+const isolineFeatureJSON = `{"type":"FeatureCollection","features":[{
+	"type":"Feature",
+	"properties":{"id":"iso-1","lat":48.8566,"lon":2.3522,"mode":"walk","type":"time","range":600},
+	"geometry":{"type":"MultiPolygon","coordinates":[[[[2.34,48.85],[2.36,48.85],[2.36,48.86],[2.34,48.85]]]]}
+}]}`
+
+func TestIsolines_TypedResponse(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(isolineFeatureJSON))
+	})
+
+	result, err := client.Isolines().At(48.8566, 2.3522).Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, result.Pending(), false)
+	assertEqual(t, result.ID, "iso-1")
+	assertEqual(t, len(result.Isolines), 1)
+
+	iso := result.Isolines[0]
+	assertEqual(t, iso.Range, 600.0)
+	assertEqual(t, iso.Mode, ModeWalk)
+	assertEqual(t, iso.Type, IsolineTime)
+	assertEqual(t, iso.Origin, LatLon(48.8566, 2.3522))
+	assertEqual(t, len(iso.Geometry), 1)
+	assertEqual(t, len(iso.Geometry[0][0]), 4)
+	assertEqual(t, iso.Geometry[0][0][1], LonLat(2.36, 48.85))
+}
+
+func TestIsolines_DoAndWait(t *testing.T) {
+	var calls atomic.Int32
+	var submitted, firstPoll time.Time
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		if n == 1 {
+			submitted = time.Now()
+			assertEqual(t, r.URL.Query().Get("lat"), "48.856600")
+		} else {
+			if n == 2 {
+				firstPoll = time.Now()
+			}
+			assertEqual(t, r.URL.Query().Get("id"), "iso-1")
+		}
+		if n < 3 {
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"properties":{"id":"iso-1"},"status":"pending"}`))
+			return
+		}
+		w.Write([]byte(isolineFeatureJSON))
+	})
+
+	result, err := client.Isolines().
+		At(48.8566, 2.3522).
+		WithPollInterval(20*time.Millisecond, 40*time.Millisecond).
+		DoAndWait(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, calls.Load(), int32(3))
+	if gap := firstPoll.Sub(submitted); gap < 20*time.Millisecond {
+		t.Errorf("first poll %s after submission, want at least one interval", gap)
+	}
+	assertEqual(t, result.Pending(), false)
+	assertEqual(t, len(result.Isolines), 1)
+}
+
+func TestIsolines_DoAndWaitContextCancelled(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"properties":{"id":"iso-1"},"status":"pending"}`))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.Isolines().
+		At(48.8566, 2.3522).
+		WithPollInterval(5*time.Millisecond, 5*time.Millisecond).
+		DoAndWait(ctx)
+	assertError(t, err)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
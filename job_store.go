@@ -0,0 +1,184 @@
+package geoapify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+// BatchJobState is the state of a recorded batch job.
+type BatchJobState string
+
+const (
+	JobSubmitted BatchJobState = "submitted"
+	JobFinished  BatchJobState = "finished"
+	JobFailed    BatchJobState = "failed"
+)
+
+// BatchJobRecord records a batch job submitted for one chunk of a run.
+type BatchJobRecord struct {
+	// Fingerprint identifies the run: the API, its parameters, the chunk size
+	// and the full input.
+	Fingerprint string        `json:"fingerprint"`
+	JobID       string        `json:"job_id"`
+	Path        string        `json:"path"`
+	Start       int           `json:"start"`
+	End         int           `json:"end"`
+	State       BatchJobState `json:"state"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
+// JobStore persists batch job records so that an interrupted run can collect
+// the results of jobs it already submitted instead of paying for them again.
+// Implementations must be safe for concurrent use.
+type JobStore interface {
+	// Jobs returns the records of a run, ordered by Start.
+	Jobs(ctx context.Context, fingerprint string) ([]BatchJobRecord, error)
+	// SaveJob inserts or replaces the record of the chunk starting at
+	// rec.Start.
+	SaveJob(ctx context.Context, rec BatchJobRecord) error
+	// DeleteJobs removes every record of a run.
+	DeleteJobs(ctx context.Context, fingerprint string) error
+}
+
+// MemoryJobStore is a JobStore that keeps records in memory. It lets a run
+// resume after a transient failure within the same process.
+type MemoryJobStore struct {
+	mu   sync.Mutex
+	runs map[string][]BatchJobRecord
+}
+
+// NewMemoryJobStore returns an empty in-memory job store.
+func NewMemoryJobStore() *MemoryJobStore {
+	return &MemoryJobStore{runs: map[string][]BatchJobRecord{}}
+}
+
+// Jobs returns the records of a run.
+func (s *MemoryJobStore) Jobs(_ context.Context, fingerprint string) ([]BatchJobRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.runs[fingerprint]), nil
+}
+
+// SaveJob inserts or replaces a record.
+func (s *MemoryJobStore) SaveJob(_ context.Context, rec BatchJobRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runs[rec.Fingerprint] = upsertJobRecord(s.runs[rec.Fingerprint], rec)
+	return nil
+}
+
+// DeleteJobs removes the records of a run.
+func (s *MemoryJobStore) DeleteJobs(_ context.Context, fingerprint string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.runs, fingerprint)
+	return nil
+}
+
+// FileJobStore is a JobStore backed by a JSON file, so runs survive a
+// process restart. The file is rewritten atomically on every change.
+type FileJobStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileJobStore returns a store that keeps its records in the file at
+// path. The file is created on first write.
+func NewFileJobStore(path string) *FileJobStore {
+	return &FileJobStore{path: path}
+}
+
+func (s *FileJobStore) load() (map[string][]BatchJobRecord, error) {
+	runs := map[string][]BatchJobRecord{}
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return runs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &runs); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+func (s *FileJobStore) save(runs map[string][]BatchJobRecord) error {
+	data, err := json.MarshalIndent(runs, "", "  ")
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, ".jobs-*")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), s.path)
+}
+
+// Jobs returns the records of a run.
+func (s *FileJobStore) Jobs(_ context.Context, fingerprint string) ([]BatchJobRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	runs, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	return runs[fingerprint], nil
+}
+
+// SaveJob inserts or replaces a record.
+func (s *FileJobStore) SaveJob(_ context.Context, rec BatchJobRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	runs, err := s.load()
+	if err != nil {
+		return err
+	}
+	runs[rec.Fingerprint] = upsertJobRecord(runs[rec.Fingerprint], rec)
+	return s.save(runs)
+}
+
+// DeleteJobs removes the records of a run.
+func (s *FileJobStore) DeleteJobs(_ context.Context, fingerprint string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	runs, err := s.load()
+	if err != nil {
+		return err
+	}
+	delete(runs, fingerprint)
+	return s.save(runs)
+}
+
+// upsertJobRecord replaces the record with the same Start or inserts it,
+// keeping the records ordered by Start.
+func upsertJobRecord(recs []BatchJobRecord, rec BatchJobRecord) []BatchJobRecord {
+	i, found := slices.BinarySearchFunc(recs, rec.Start, func(r BatchJobRecord, start int) int {
+		return r.Start - start
+	})
+	if found {
+		recs[i] = rec
+		return recs
+	}
+	return slices.Insert(recs, i, rec)
+}
@@ -0,0 +1,61 @@
+package geoapify
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestJobStores(t *testing.T) {
+	stores := map[string]func(t *testing.T) JobStore{
+		"memory": func(t *testing.T) JobStore { return NewMemoryJobStore() },
+		"file": func(t *testing.T) JobStore {
+			return NewFileJobStore(filepath.Join(t.TempDir(), "state", "jobs.json"))
+		},
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			store := newStore(t)
+
+			recs, err := store.Jobs(ctx, "run-1")
+			assertNoError(t, err)
+			assertEqual(t, len(recs), 0)
+
+			assertNoError(t, store.SaveJob(ctx, BatchJobRecord{Fingerprint: "run-1", JobID: "b", Start: 10, End: 20, State: JobSubmitted}))
+			assertNoError(t, store.SaveJob(ctx, BatchJobRecord{Fingerprint: "run-1", JobID: "a", Start: 0, End: 10, State: JobSubmitted}))
+			assertNoError(t, store.SaveJob(ctx, BatchJobRecord{Fingerprint: "run-2", JobID: "c", Start: 0, End: 5, State: JobFinished}))
+			assertNoError(t, store.SaveJob(ctx, BatchJobRecord{Fingerprint: "run-1", JobID: "b2", Start: 10, End: 20, State: JobFinished}))
+
+			recs, err = store.Jobs(ctx, "run-1")
+			assertNoError(t, err)
+			assertEqual(t, len(recs), 2)
+			assertEqual(t, recs[0].JobID, "a")
+			assertEqual(t, recs[1].JobID, "b2")
+			assertEqual(t, recs[1].State, JobFinished)
+
+			assertNoError(t, store.DeleteJobs(ctx, "run-1"))
+			recs, err = store.Jobs(ctx, "run-1")
+			assertNoError(t, err)
+			assertEqual(t, len(recs), 0)
+
+			recs, err = store.Jobs(ctx, "run-2")
+			assertNoError(t, err)
+			assertEqual(t, len(recs), 1)
+		})
+	}
+}
+
+func TestFileJobStore_Persists(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "jobs.json")
+
+	assertNoError(t, NewFileJobStore(path).SaveJob(ctx, BatchJobRecord{Fingerprint: "run", JobID: "job-1", End: 3, State: JobSubmitted}))
+
+	recs, err := NewFileJobStore(path).Jobs(ctx, "run")
+	assertNoError(t, err)
+	assertEqual(t, len(recs), 1)
+	assertEqual(t, recs[0].JobID, "job-1")
+	assertEqual(t, recs[0].State, JobSubmitted)
+}
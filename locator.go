@@ -0,0 +1,246 @@
+package geoapify
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	defaultNearestLimit     = 5
+	defaultNearestPrefilter = 50
+)
+
+// NearestRank selects how Nearest ranks candidates.
+type NearestRank string
+
+const (
+	// RankByTime ranks candidates by travel time.
+	RankByTime NearestRank = "time"
+	// RankByDistance ranks candidates by travel distance.
+	RankByDistance NearestRank = "distance"
+)
+
+// Candidate is a location, such as a store, that Nearest ranks.
+type Candidate struct {
+	ID       string
+	Location Location
+}
+
+// NearestRequest is a builder for a store-locator query: the candidates
+// closest to an origin by travel time or distance.
+type NearestRequest struct {
+	service    *RouteMatrixService
+	origin     Location
+	candidates []Candidate
+	modes      []TravelMode
+	rank       NearestRank
+	limit      int
+	prefilter  int
+	maxRadius  float64
+	chunk      int
+	traffic    TrafficModel
+	departure  time.Time
+}
+
+// Nearest creates a request ranking the candidates by how quickly they can
+// be reached from origin. Candidates are first narrowed down by
+// straight-line distance, then a one-to-many route matrix is calculated for
+// the remaining ones.
+func (s *RouteMatrixService) Nearest(origin Location, candidates ...Candidate) *NearestRequest {
+	return &NearestRequest{
+		service:    s,
+		origin:     origin,
+		candidates: candidates,
+	}
+}
+
+// WithModes sets the travel modes to rank by (default drive). Each mode is
+// ranked separately, which allows comparing walking and driving.
+func (r *NearestRequest) WithModes(modes ...TravelMode) *NearestRequest {
+	r.modes = modes
+	return r
+}
+
+// RankBy sets whether candidates are ranked by travel time (the default) or
+// travel distance.
+func (r *NearestRequest) RankBy(rank NearestRank) *NearestRequest {
+	r.rank = rank
+	return r
+}
+
+// WithLimit sets the number of candidates returned per mode (default 5).
+func (r *NearestRequest) WithLimit(n int) *NearestRequest {
+	r.limit = n
+	return r
+}
+
+// WithPrefilter sets how many of the closest candidates by straight-line
+// distance are routed (default 50), and optionally a radius in meters
+// beyond which candidates are ignored. A radius of 0 disables it.
+func (r *NearestRequest) WithPrefilter(count int, radiusMeters float64) *NearestRequest {
+	r.prefilter = count
+	r.maxRadius = radiusMeters
+	return r
+}
+
+// WithChunkSize sets the maximum number of targets per route matrix
+// request (default 500).
+func (r *NearestRequest) WithChunkSize(n int) *NearestRequest {
+	r.chunk = n
+	return r
+}
+
+// WithTraffic sets the traffic model of the route matrix.
+func (r *NearestRequest) WithTraffic(t TrafficModel) *NearestRequest {
+	r.traffic = t
+	return r
+}
+
+// WithDeparture sets the departure time the ETAs are computed from
+// (default now).
+func (r *NearestRequest) WithDeparture(t time.Time) *NearestRequest {
+	r.departure = t
+	return r
+}
+
+// Do prefilters the candidates, calculates the route matrix for every mode
+// and ranks the reachable candidates.
+func (r *NearestRequest) Do(ctx context.Context) (*NearestResponse, error) {
+	rank := r.rank
+	if rank == "" {
+		rank = RankByTime
+	}
+	if rank != RankByTime && rank != RankByDistance {
+		return nil, fmt.Errorf("%w: unknown rank %q", ErrInvalidArgument, rank)
+	}
+	if r.limit < 0 || r.prefilter < 0 || r.maxRadius < 0 {
+		return nil, fmt.Errorf("%w: limit, prefilter and radius must not be negative", ErrInvalidArgument)
+	}
+	modes := r.modes
+	if len(modes) == 0 {
+		modes = []TravelMode{ModeDrive}
+	}
+	limit := r.limit
+	if limit == 0 {
+		limit = defaultNearestLimit
+	}
+	departure := r.departure
+	if departure.IsZero() {
+		departure = time.Now()
+	}
+
+	shortlist := r.shortlist()
+	result := &NearestResponse{}
+	if len(shortlist) == 0 {
+		for _, mode := range modes {
+			result.Rankings = append(result.Rankings, NearestRanking{Mode: mode})
+		}
+		return result, nil
+	}
+	targets := make([]Location, len(shortlist))
+	for i, c := range shortlist {
+		targets[i] = c.Location
+	}
+
+	for _, mode := range modes {
+		entries, err := r.service.Calculate().
+			Sources(r.origin).
+			Targets(targets...).
+			WithMode(mode).
+			WithTraffic(r.traffic).
+			doOneToMany(ctx, r.chunk)
+		if err != nil {
+			return nil, fmt.Errorf("ranking by %s: %w", mode, err)
+		}
+
+		ranking := NearestRanking{Mode: mode}
+		for i, c := range shortlist {
+			e := entries[i]
+			if e.Unreachable {
+				ranking.Unreachable = append(ranking.Unreachable, c.Candidate)
+				continue
+			}
+			c.TravelTime = time.Duration(e.Time * float64(time.Second))
+			c.TravelDistance = e.Distance
+			c.ETA = departure.Add(c.TravelTime)
+			ranking.Candidates = append(ranking.Candidates, c)
+		}
+		slices.SortStableFunc(ranking.Candidates, func(a, b RankedCandidate) int {
+			if rank == RankByDistance {
+				return cmp.Compare(a.TravelDistance, b.TravelDistance)
+			}
+			return cmp.Compare(a.TravelTime, b.TravelTime)
+		})
+		if len(ranking.Candidates) > limit {
+			ranking.Candidates = ranking.Candidates[:limit]
+		}
+		result.Rankings = append(result.Rankings, ranking)
+	}
+	return result, nil
+}
+
+// shortlist returns the candidates within the radius, closest first,
+// capped at the prefilter count.
+func (r *NearestRequest) shortlist() []RankedCandidate {
+	var list []RankedCandidate
+	for _, c := range r.candidates {
+		d := r.origin.HaversineDistance(c.Location)
+		if r.maxRadius > 0 && d > r.maxRadius {
+			continue
+		}
+		list = append(list, RankedCandidate{Candidate: c, StraightLineDistance: d})
+	}
+	slices.SortStableFunc(list, func(a, b RankedCandidate) int {
+		return cmp.Compare(a.StraightLineDistance, b.StraightLineDistance)
+	})
+	prefilter := r.prefilter
+	if prefilter == 0 {
+		prefilter = defaultNearestPrefilter
+	}
+	if len(list) > prefilter {
+		list = list[:prefilter]
+	}
+	return list
+}
+
+// NearestResponse holds one ranking per requested travel mode, in the order
+// the modes were given.
+type NearestResponse struct {
+	Rankings []NearestRanking
+}
+
+// ByMode returns the ranked candidates for mode, or nil if the mode was not
+// requested.
+func (r *NearestResponse) ByMode(mode TravelMode) []RankedCandidate {
+	for _, ranking := range r.Rankings {
+		if ranking.Mode == mode {
+			return ranking.Candidates
+		}
+	}
+	return nil
+}
+
+// NearestRanking is the ranking of the candidates for one travel mode.
+type NearestRanking struct {
+	Mode       TravelMode
+	Candidates []RankedCandidate
+	// Unreachable lists shortlisted candidates the router found no route
+	// to.
+	Unreachable []Candidate
+}
+
+// RankedCandidate is a candidate with its distances and travel time from
+// the origin.
+type RankedCandidate struct {
+	Candidate
+	// StraightLineDistance is the great-circle distance in meters.
+	StraightLineDistance float64
+	TravelTime           time.Duration
+	// TravelDistance is the route length in meters.
+	TravelDistance float64
+	// ETA is the departure time plus the travel time.
+	ETA time.Time
+}
@@ -0,0 +1,119 @@
+package geoapify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRouteMatrix_Nearest(t *testing.T) {
+	var requests []routeMatrixBody
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assertEqual(t, r.URL.Path, "/v1/routematrix")
+		var b routeMatrixBody
+		assertNoError(t, json.NewDecoder(r.Body).Decode(&b))
+		requests = append(requests, b)
+		// Time grows with latitude when walking and shrinks when driving;
+		// distance always grows with latitude. Latitude 0.04 has no route.
+		row := make([]map[string]any, len(b.Targets))
+		for i, tgt := range b.Targets {
+			lat := tgt.Location[1]
+			if lat == 0.04 {
+				row[i] = map[string]any{"target_index": i, "time": nil, "distance": nil}
+				continue
+			}
+			tm := lat * 100000
+			if b.Mode == ModeDrive {
+				tm = 1000 - lat*10000
+			}
+			row[i] = map[string]any{"target_index": i, "time": tm, "distance": lat * 100000}
+		}
+		w.Write(mustJSON(t, map[string]any{"sources_to_targets": [][]map[string]any{row}}))
+	})
+
+	candidates := []Candidate{
+		{ID: "a", Location: LatLon(0.01, 0)},
+		{ID: "b", Location: LatLon(0.02, 0)},
+		{ID: "c", Location: LatLon(0.03, 0)},
+		{ID: "d", Location: LatLon(0.04, 0)},
+		{ID: "e", Location: LatLon(0.05, 0)},
+		{ID: "far", Location: LatLon(1, 0)},
+	}
+	departure := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	got, err := client.RouteMatrix().Nearest(LatLon(0, 0), candidates...).
+		WithModes(ModeWalk, ModeDrive).
+		WithLimit(2).
+		WithPrefilter(10, 10000).
+		WithChunkSize(2).
+		WithDeparture(departure).
+		Do(context.Background())
+	assertNoError(t, err)
+
+	// Five shortlisted targets in chunks of two, for two modes.
+	assertEqual(t, len(requests), 6)
+	for _, b := range requests {
+		assertEqual(t, len(b.Sources), 1)
+	}
+
+	assertEqual(t, len(got.Rankings), 2)
+	walk := got.ByMode(ModeWalk)
+	assertEqual(t, len(walk), 2)
+	assertEqual(t, walk[0].ID, "a")
+	assertEqual(t, walk[1].ID, "b")
+	assertEqual(t, walk[0].TravelTime, 1000*time.Second)
+	assertEqual(t, walk[0].ETA, departure.Add(1000*time.Second))
+	assertNear(t, walk[0].StraightLineDistance, 1111.95, 1)
+
+	drive := got.ByMode(ModeDrive)
+	assertEqual(t, drive[0].ID, "e")
+	assertEqual(t, drive[1].ID, "c")
+	assertEqual(t, len(got.Rankings[1].Unreachable), 1)
+	assertEqual(t, got.Rankings[1].Unreachable[0].ID, "d")
+	assertEqual(t, got.ByMode(ModeBicycle) == nil, true)
+}
+
+func TestRouteMatrix_Nearest_RankByDistance(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var b routeMatrixBody
+		assertNoError(t, json.NewDecoder(r.Body).Decode(&b))
+		assertEqual(t, b.Mode, ModeDrive)
+		// Only the two closest candidates survive the prefilter.
+		assertEqual(t, len(b.Targets), 2)
+		w.Write([]byte(`{"sources_to_targets":[[
+			{"target_index":0,"time":100,"distance":900},
+			{"target_index":1,"time":50,"distance":800}]]}`))
+	})
+
+	got, err := client.RouteMatrix().Nearest(LatLon(0, 0),
+		Candidate{ID: "x", Location: LatLon(0.01, 0)},
+		Candidate{ID: "y", Location: LatLon(0.02, 0)},
+		Candidate{ID: "z", Location: LatLon(0.03, 0)},
+	).WithPrefilter(2, 0).RankBy(RankByDistance).Do(context.Background())
+	assertNoError(t, err)
+	ranked := got.ByMode(ModeDrive)
+	assertEqual(t, len(ranked), 2)
+	assertEqual(t, ranked[0].ID, "y")
+	assertEqual(t, ranked[0].TravelDistance, 800.0)
+}
+
+func TestRouteMatrix_Nearest_NoCandidates(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("route matrix should not be called")
+	})
+
+	got, err := client.RouteMatrix().Nearest(LatLon(0, 0), Candidate{ID: "far", Location: LatLon(10, 10)}).
+		WithPrefilter(0, 1000).
+		Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, len(got.Rankings), 1)
+	assertEqual(t, len(got.ByMode(ModeDrive)), 0)
+
+	_, err = client.RouteMatrix().Nearest(LatLon(0, 0)).RankBy("eta").Do(context.Background())
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument, got %v", err)
+	}
+}
@@ -2,6 +2,8 @@ package geoapify
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
 )
 
 // MapMatchingService provides access to the GeoApify Map Matching API.
@@ -34,16 +36,35 @@ func (r *MapMatchingRequest) WithMode(mode TravelMode) *MapMatchingRequest {
 }
 
 // Do executes the map matching request.
-func (r *MapMatchingRequest) Do(ctx context.Context) (*GeoJSONFeatureCollection, error) {
+func (r *MapMatchingRequest) Do(ctx context.Context) (*MapMatchingResponse, error) {
 	body := mapMatchingBody{
 		Mode:      r.mode,
 		Waypoints: r.waypoints,
 	}
 
-	var result GeoJSONFeatureCollection
+	var result MapMatchingResponse
 	if err := r.service.client.doPost(ctx, "/v1/mapmatching", nil, body, &result); err != nil {
 		return nil, err
 	}
+
+	// Inputs the API dropped entirely are unmatched as well.
+	for i := range result.Routes {
+		route := &result.Routes[i]
+		seen := make(map[int]bool, len(route.Waypoints))
+		for j, wp := range route.Waypoints {
+			seen[wp.OriginalIndex] = true
+			if wp.OriginalIndex >= 0 && wp.OriginalIndex < len(r.waypoints) {
+				loc := r.waypoints[wp.OriginalIndex].Location
+				route.Waypoints[j].OriginalLocation = LonLat(loc[0], loc[1])
+			}
+		}
+		for j := range r.waypoints {
+			if !seen[j] {
+				route.Unmatched = append(route.Unmatched, j)
+			}
+		}
+		sort.Ints(route.Unmatched)
+	}
 	return &result, nil
 }
 
@@ -58,3 +79,113 @@ type mapMatchingBody struct {
 	Mode      TravelMode            `json:"mode"`
 	Waypoints []MapMatchingWaypoint `json:"waypoints"`
 }
+
+// MapMatchingResponse is the response from the map matching API.
+type MapMatchingResponse struct {
+	GeoJSONFeatureCollection
+	// Routes holds the typed matched routes, one per feature.
+	Routes []MatchedRoute `json:"-"`
+}
+
+// UnmarshalJSON implements custom unmarshalling for MapMatchingResponse.
+func (r *MapMatchingResponse) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.GeoJSONFeatureCollection); err != nil {
+		return err
+	}
+
+	var raw struct {
+		Features []struct {
+			Properties MatchedRoute  `json:"properties"`
+			Geometry   routeGeometry `json:"geometry"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	r.Routes = r.Routes[:0]
+	for _, f := range raw.Features {
+		route := f.Properties
+		geometry, err := decodeRouteGeometry(f.Geometry)
+		if err != nil {
+			return err
+		}
+		route.Geometry = geometry
+		attachLegGeometry(route.Legs, route.Geometry)
+		for _, wp := range route.Waypoints {
+			if wp.MatchType == MatchUnmatched {
+				route.Unmatched = append(route.Unmatched, wp.OriginalIndex)
+			}
+		}
+		r.Routes = append(r.Routes, route)
+	}
+	return nil
+}
+
+// MatchType describes how an input point was matched to the road network.
+type MatchType string
+
+const (
+	MatchMatched      MatchType = "matched"
+	MatchInterpolated MatchType = "interpolated"
+	MatchUnmatched    MatchType = "unmatched"
+)
+
+// MatchedRoute is a GPS track matched to the road network.
+type MatchedRoute struct {
+	Mode          TravelMode        `json:"mode,omitempty"`
+	Distance      float64           `json:"distance"`
+	DistanceUnits string            `json:"distance_units,omitempty"`
+	Time          float64           `json:"time"`
+	Legs          []RouteLeg        `json:"legs"`
+	Waypoints     []MatchedWaypoint `json:"waypoints"`
+	// Geometry holds one polyline per leg.
+	Geometry [][]Location `json:"-"`
+	// Unmatched lists the indices of the input waypoints that could not be
+	// matched to a road.
+	Unmatched []int `json:"-"`
+}
+
+// MatchedWaypoint is an input point snapped to the road network.
+type MatchedWaypoint struct {
+	// Location is the snapped location on the road network.
+	Location Location
+	// OriginalLocation is the input point.
+	OriginalLocation Location
+	OriginalIndex    int
+	MatchType        MatchType
+	// MatchDistance is the distance from the input point to the road.
+	MatchDistance float64
+	// LegIndex and StepIndex locate the point on the route. They are nil
+	// for unmatched points.
+	LegIndex  *int
+	StepIndex *int
+}
+
+// UnmarshalJSON implements custom unmarshalling for MatchedWaypoint.
+func (w *MatchedWaypoint) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Location         []float64 `json:"location"`
+		OriginalLocation []float64 `json:"original_location"`
+		OriginalIndex    int       `json:"original_index"`
+		MatchType        MatchType `json:"match_type"`
+		MatchDistance    float64   `json:"match_distance"`
+		LegIndex         *int      `json:"leg_index"`
+		StepIndex        *int      `json:"step_index"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw.Location) >= 2 {
+		w.Location = LonLat(raw.Location[0], raw.Location[1])
+	}
+	if len(raw.OriginalLocation) >= 2 {
+		w.OriginalLocation = LonLat(raw.OriginalLocation[0], raw.OriginalLocation[1])
+	}
+	w.OriginalIndex = raw.OriginalIndex
+	w.MatchType = raw.MatchType
+	w.MatchDistance = raw.MatchDistance
+	w.LegIndex = raw.LegIndex
+	w.StepIndex = raw.StepIndex
+	return nil
+}
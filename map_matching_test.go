@@ -132,3 +132,61 @@ func TestMapMatching_ErrorHandling(t *testing.T) {
 	assertEqual(t, apiErr.StatusCode, 400)
 	assertEqual(t, apiErr.Message, "Invalid waypoints")
 }
+
+func TestMapMatching_TypedResponse(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"type":"FeatureCollection","features":[{
+			"type":"Feature",
+			"properties":{
+				"mode":"drive","distance":1500,"time":120,
+				"waypoints":[
+					{"location":[2.3501,48.8501],"original_index":0,"match_type":"matched","match_distance":3.5,"leg_index":0,"step_index":0},
+					{"location":[2.3601,48.8601],"original_index":2,"match_type":"interpolated","match_distance":12,"leg_index":0,"step_index":1},
+					{"location":[2.3701,48.8701],"original_index":3,"match_type":"unmatched","match_distance":250}
+				],
+				"legs":[{"distance":1500,"time":120,"steps":[
+					{"distance":700,"time":60,"from_index":0,"to_index":1},
+					{"distance":800,"time":60,"from_index":1,"to_index":2}
+				]}]
+			},
+			"geometry":{"type":"MultiLineString","coordinates":[[[2.3501,48.8501],[2.355,48.855],[2.3601,48.8601]]]}
+		}]}`))
+	})
+
+	got, err := client.MapMatching().Match().
+		Waypoints(
+			MapMatchingWaypoint{Location: [2]float64{2.35, 48.85}},
+			MapMatchingWaypoint{Location: [2]float64{2.355, 48.855}},
+			MapMatchingWaypoint{Location: [2]float64{2.36, 48.86}},
+			MapMatchingWaypoint{Location: [2]float64{2.37, 48.87}},
+		).
+		WithMode(ModeDrive).
+		Do(context.Background())
+	assertNoError(t, err)
+
+	assertEqual(t, len(got.Features), 1)
+	assertEqual(t, len(got.Routes), 1)
+	route := got.Routes[0]
+	assertEqual(t, route.Mode, ModeDrive)
+	assertEqual(t, route.Distance, 1500.0)
+	assertEqual(t, len(route.Legs), 1)
+	assertEqual(t, len(route.Legs[0].StepGeometry(1)), 2)
+	assertEqual(t, len(route.Geometry[0]), 3)
+
+	assertEqual(t, len(route.Waypoints), 3)
+	wp := route.Waypoints[1]
+	assertEqual(t, wp.Location, LonLat(2.3601, 48.8601))
+	assertEqual(t, wp.OriginalLocation, LonLat(2.36, 48.86))
+	assertEqual(t, wp.MatchType, MatchInterpolated)
+	assertEqual(t, wp.MatchDistance, 12.0)
+	assertEqual(t, *wp.LegIndex, 0)
+	assertEqual(t, *wp.StepIndex, 1)
+	unmatched := route.Waypoints[2]
+	assertEqual(t, unmatched.MatchType, MatchUnmatched)
+	assertEqual(t, unmatched.LegIndex == nil, true)
+	assertEqual(t, unmatched.StepIndex == nil, true)
+
+	assertEqual(t, len(route.Unmatched), 2)
+	assertEqual(t, route.Unmatched[0], 1)
+	assertEqual(t, route.Unmatched[1], 3)
+}
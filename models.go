@@ -1,6 +1,9 @@
 package geoapify
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 // Format represents the response format.
 type Format string
@@ -109,6 +112,37 @@ const (
 	Geometry10000     GeometryType = "geometry_10000"
 )
 
+// MapStyle represents a map rendering style.
+type MapStyle string
+
+const (
+	StyleOSMCarto              MapStyle = "osm-carto"
+	StyleOSMBright             MapStyle = "osm-bright"
+	StyleOSMBrightGrey         MapStyle = "osm-bright-grey"
+	StyleOSMBrightSmooth       MapStyle = "osm-bright-smooth"
+	StyleKlokantechBasic       MapStyle = "klokantech-basic"
+	StyleOSMLiberty            MapStyle = "osm-liberty"
+	StyleMaptiler3D            MapStyle = "maptiler-3d"
+	StyleToner                 MapStyle = "toner"
+	StyleTonerGrey             MapStyle = "toner-grey"
+	StylePositron              MapStyle = "positron"
+	StylePositronBlue          MapStyle = "positron-blue"
+	StylePositronRed           MapStyle = "positron-red"
+	StyleDarkMatter            MapStyle = "dark-matter"
+	StyleDarkMatterBrown       MapStyle = "dark-matter-brown"
+	StyleDarkMatterDarkGrey    MapStyle = "dark-matter-dark-grey"
+	StyleDarkMatterDarkPurple  MapStyle = "dark-matter-dark-purple"
+	StyleDarkMatterYellowRoads MapStyle = "dark-matter-yellow-roads"
+)
+
+// ImageFormat represents a raster image format.
+type ImageFormat string
+
+const (
+	ImagePNG  ImageFormat = "png"
+	ImageJPEG ImageFormat = "jpeg"
+)
+
 // Location represents a geographic coordinate pair.
 type Location struct {
 	Lat float64
@@ -125,59 +159,6 @@ func LonLat(lon, lat float64) Location {
 	return Location{Lat: lat, Lon: lon}
 }
 
-// Filter types for geocoding and places APIs.
-
-// CountryFilter creates a country code filter.
-func CountryFilter(codes ...string) string {
-	return "countrycode:" + joinStrings(codes, ",")
-}
-
-// CircleFilter creates a circle filter.
-func CircleFilter(lon, lat, radiusMeters float64) string {
-	return fmt.Sprintf("circle:%f,%f,%f", lon, lat, radiusMeters)
-}
-
-// RectFilter creates a rectangle filter.
-func RectFilter(lon1, lat1, lon2, lat2 float64) string {
-	return fmt.Sprintf("rect:%f,%f,%f,%f", lon1, lat1, lon2, lat2)
-}
-
-// PlaceFilter creates a place ID filter.
-func PlaceFilter(placeID string) string {
-	return "place:" + placeID
-}
-
-// ProximityBias creates a proximity bias.
-func ProximityBias(lon, lat float64) string {
-	return fmt.Sprintf("proximity:%f,%f", lon, lat)
-}
-
-// CircleBias creates a circle bias.
-func CircleBias(lon, lat, radiusMeters float64) string {
-	return fmt.Sprintf("circle:%f,%f,%f", lon, lat, radiusMeters)
-}
-
-// RectBias creates a rectangle bias.
-func RectBias(lon1, lat1, lon2, lat2 float64) string {
-	return fmt.Sprintf("rect:%f,%f,%f,%f", lon1, lat1, lon2, lat2)
-}
-
-// CountryBias creates a country code bias.
-func CountryBias(codes ...string) string {
-	return "countrycode:" + joinStrings(codes, ",")
-}
-
-func joinStrings(s []string, sep string) string {
-	result := ""
-	for i, v := range s {
-		if i > 0 {
-			result += sep
-		}
-		result += v
-	}
-	return result
-}
-
 // Address represents a geocoded address result.
 type Address struct {
 	Name          string    `json:"name,omitempty"`
@@ -257,3 +238,71 @@ type GeoJSONGeometry struct {
 	Type        string `json:"type"`
 	Coordinates any    `json:"coordinates"`
 }
+
+// Polygon is a list of linear rings. The first ring is the outer boundary and
+// any further rings are holes.
+type Polygon [][]Location
+
+// MultiPolygon is a list of polygons.
+type MultiPolygon []Polygon
+
+// Polygons returns the geometry as a MultiPolygon. A Polygon geometry is
+// returned as a MultiPolygon with a single element; other geometry types
+// yield nil.
+func (g *GeoJSONGeometry) Polygons() (MultiPolygon, error) {
+	if g == nil {
+		return nil, nil
+	}
+	switch g.Type {
+	case "Polygon":
+		var coords [][][]float64
+		if err := remarshal(g.Coordinates, &coords); err != nil {
+			return nil, fmt.Errorf("decoding polygon: %w", err)
+		}
+		return MultiPolygon{toPolygon(coords)}, nil
+	case "MultiPolygon":
+		var coords [][][][]float64
+		if err := remarshal(g.Coordinates, &coords); err != nil {
+			return nil, fmt.Errorf("decoding multipolygon: %w", err)
+		}
+		out := make(MultiPolygon, len(coords))
+		for i, p := range coords {
+			out[i] = toPolygon(p)
+		}
+		return out, nil
+	}
+	return nil, nil
+}
+
+// point returns the position of a Point geometry. ok is false for other
+// geometry types and for points without coordinates.
+func (g *GeoJSONGeometry) point() (loc Location, ok bool, err error) {
+	if g == nil || g.Type != "Point" {
+		return Location{}, false, nil
+	}
+	var c []float64
+	if err := remarshal(g.Coordinates, &c); err != nil {
+		return Location{}, false, fmt.Errorf("decoding point: %w", err)
+	}
+	if len(c) < 2 {
+		return Location{}, false, nil
+	}
+	return LonLat(c[0], c[1]), true, nil
+}
+
+func toPolygon(rings [][][]float64) Polygon {
+	out := make(Polygon, len(rings))
+	for i, ring := range rings {
+		out[i] = toLocations(ring)
+	}
+	return out
+}
+
+// remarshal converts a generically decoded JSON value into v.
+func remarshal(in any, v any) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
@@ -53,8 +53,7 @@ func (r *PlaceDetailsRequest) WithLang(v string) *PlaceDetailsRequest {
 	return r
 }
 
-// Do executes the place details request.
-func (r *PlaceDetailsRequest) Do(ctx context.Context) (*GeoJSONFeatureCollection, error) {
+func (r *PlaceDetailsRequest) params() (url.Values, error) {
 	params := url.Values{}
 	if r.placeID != "" {
 		params.Set("id", r.placeID)
@@ -69,6 +68,15 @@ func (r *PlaceDetailsRequest) Do(ctx context.Context) (*GeoJSONFeatureCollection
 	if r.lang != "" {
 		params.Set("lang", r.lang)
 	}
+	return params, nil
+}
+
+// Do executes the place details request.
+func (r *PlaceDetailsRequest) Do(ctx context.Context) (*GeoJSONFeatureCollection, error) {
+	params, err := r.params()
+	if err != nil {
+		return nil, err
+	}
 
 	var result GeoJSONFeatureCollection
 	if err := r.client.doGet(ctx, "/v2/place-details", params, &result); err != nil {
@@ -2,9 +2,11 @@ package geoapify
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 // PlacesService provides access to the GeoApify Places API.
@@ -15,18 +17,22 @@ type PlacesService struct {
 // PlacesRequest is a builder for a places API call.
 type PlacesRequest struct {
 	client     *Client
-	categories []string
-	conditions []string
-	filters    []string
-	biases     []string
+	categories []Category
+	conditions []Condition
+	filters    []Filter
+	biases     []Bias
 	limit      int
 	offset     int
 	lang       string
 	name       string
+	maxResults int
+	unchecked  bool
 }
 
-// Categories creates a new PlacesRequest for the given categories.
-func (s *PlacesService) Categories(categories ...string) *PlacesRequest {
+// Categories creates a new PlacesRequest for the given categories. Unknown
+// categories and conditions are reported by Do before any request is sent,
+// unless AllowUnknownCategories is set.
+func (s *PlacesService) Categories(categories ...Category) *PlacesRequest {
 	return &PlacesRequest{
 		client:     s.client,
 		categories: categories,
@@ -34,19 +40,27 @@ func (s *PlacesService) Categories(categories ...string) *PlacesRequest {
 }
 
 // WithConditions adds conditions to the request.
-func (r *PlacesRequest) WithConditions(conditions ...string) *PlacesRequest {
+func (r *PlacesRequest) WithConditions(conditions ...Condition) *PlacesRequest {
 	r.conditions = append(r.conditions, conditions...)
 	return r
 }
 
+// AllowUnknownCategories sends categories and conditions as given instead
+// of checking them against the lists known to this package, for values the
+// API added since.
+func (r *PlacesRequest) AllowUnknownCategories() *PlacesRequest {
+	r.unchecked = true
+	return r
+}
+
 // WithFilter adds filters to the request.
-func (r *PlacesRequest) WithFilter(filters ...string) *PlacesRequest {
+func (r *PlacesRequest) WithFilter(filters ...Filter) *PlacesRequest {
 	r.filters = append(r.filters, filters...)
 	return r
 }
 
 // WithBias adds biases to the request.
-func (r *PlacesRequest) WithBias(biases ...string) *PlacesRequest {
+func (r *PlacesRequest) WithBias(biases ...Bias) *PlacesRequest {
 	r.biases = append(r.biases, biases...)
 	return r
 }
@@ -75,20 +89,24 @@ func (r *PlacesRequest) WithName(v string) *PlacesRequest {
 	return r
 }
 
-// Do executes the places request.
-func (r *PlacesRequest) Do(ctx context.Context) (*GeoJSONFeatureCollection, error) {
+func (r *PlacesRequest) params() (url.Values, error) {
+	if !r.unchecked {
+		if err := validateCategories(r.categories); err != nil {
+			return nil, err
+		}
+		if err := validateConditions(r.conditions); err != nil {
+			return nil, err
+		}
+	}
 	params := url.Values{}
 	if len(r.categories) > 0 {
-		params.Set("categories", strings.Join(r.categories, ","))
+		params.Set("categories", joinCategories(r.categories))
 	}
 	if len(r.conditions) > 0 {
-		params.Set("conditions", strings.Join(r.conditions, ","))
+		params.Set("conditions", joinConditions(r.conditions))
 	}
-	if len(r.filters) > 0 {
-		params.Set("filter", strings.Join(r.filters, "|"))
-	}
-	if len(r.biases) > 0 {
-		params.Set("bias", strings.Join(r.biases, "|"))
+	if err := setFilterParams(params, r.filters, r.biases); err != nil {
+		return nil, err
 	}
 	if r.limit > 0 {
 		params.Set("limit", strconv.Itoa(r.limit))
@@ -102,10 +120,171 @@ func (r *PlacesRequest) Do(ctx context.Context) (*GeoJSONFeatureCollection, erro
 	if r.name != "" {
 		params.Set("name", r.name)
 	}
+	return params, nil
+}
 
-	var result GeoJSONFeatureCollection
+// Do executes the places request.
+func (r *PlacesRequest) Do(ctx context.Context) (*PlacesResponse, error) {
+	params, err := r.params()
+	if err != nil {
+		return nil, err
+	}
+
+	var result PlacesResponse
 	if err := r.client.doGet(ctx, "/v2/places", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
+
+// WithMaxResults caps the number of places All yields.
+func (r *PlacesRequest) WithMaxResults(n int) *PlacesRequest {
+	r.maxResults = n
+	return r
+}
+
+// defaultPlacesPageSize is the page size All uses when no limit is set.
+const defaultPlacesPageSize = 100
+
+// All iterates over every matching place, fetching pages of WithLimit
+// results (100 by default) starting at WithOffset. Paging stops on a short
+// page or once WithMaxResults places were yielded. Places repeated across
+// pages are yielded once. Requests go through the client's rate limit and
+// retry settings; iteration stops at the first error, which is yielded with
+// a zero Place.
+func (r *PlacesRequest) All(ctx context.Context) iter.Seq2[Place, error] {
+	return func(yield func(Place, error) bool) {
+		size := r.limit
+		if size <= 0 {
+			size = defaultPlacesPageSize
+		}
+		seen := map[string]bool{}
+		count := 0
+		for offset := r.offset; ; offset += size {
+			page := *r
+			page.limit = size
+			page.offset = offset
+			resp, err := page.Do(ctx)
+			if err != nil {
+				yield(Place{}, err)
+				return
+			}
+			for _, p := range resp.Places {
+				if p.PlaceID != "" {
+					if seen[p.PlaceID] {
+						continue
+					}
+					seen[p.PlaceID] = true
+				}
+				if !yield(p, nil) {
+					return
+				}
+				count++
+				if r.maxResults > 0 && count >= r.maxResults {
+					return
+				}
+			}
+			if len(resp.Features) < size {
+				return
+			}
+		}
+	}
+}
+
+// PlacesResponse is the response from the Places API.
+type PlacesResponse struct {
+	GeoJSONFeatureCollection
+	Places []Place `json:"-"`
+}
+
+// Place is a point of interest returned by the Places API.
+type Place struct {
+	PlaceID      string
+	Name         string
+	Categories   []Category
+	Location     Location
+	Formatted    string
+	AddressLine1 string
+	AddressLine2 string
+	HouseNumber  string
+	Street       string
+	Postcode     string
+	City         string
+	State        string
+	Country      string
+	CountryCode  string
+	// Distance is the distance in meters from the bias or filter center,
+	// when the request had one.
+	Distance   float64
+	Properties map[string]any
+}
+
+// HasCategory reports whether the place belongs to c or to one of its
+// subcategories.
+func (p Place) HasCategory(c Category) bool {
+	for _, pc := range p.Categories {
+		if c.Includes(pc) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalJSON decodes the feature collection and builds the typed
+// places.
+func (r *PlacesResponse) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.GeoJSONFeatureCollection); err != nil {
+		return err
+	}
+	r.Places = r.Places[:0]
+	for _, f := range r.Features {
+		p, err := newPlace(f)
+		if err != nil {
+			return err
+		}
+		r.Places = append(r.Places, p)
+	}
+	return nil
+}
+
+func newPlace(f GeoJSONFeature) (Place, error) {
+	var props struct {
+		PlaceID      string     `json:"place_id"`
+		Name         string     `json:"name"`
+		Categories   []Category `json:"categories"`
+		Lat          float64    `json:"lat"`
+		Lon          float64    `json:"lon"`
+		Formatted    string     `json:"formatted"`
+		AddressLine1 string     `json:"address_line1"`
+		AddressLine2 string     `json:"address_line2"`
+		HouseNumber  string     `json:"housenumber"`
+		Street       string     `json:"street"`
+		Postcode     string     `json:"postcode"`
+		City         string     `json:"city"`
+		State        string     `json:"state"`
+		Country      string     `json:"country"`
+		CountryCode  string     `json:"country_code"`
+		Distance     float64    `json:"distance"`
+	}
+	if err := remarshal(f.Properties, &props); err != nil {
+		return Place{}, fmt.Errorf("decoding place properties: %w", err)
+	}
+	return Place{
+		PlaceID:      props.PlaceID,
+		Name:         props.Name,
+		Categories:   props.Categories,
+		Location:     LatLon(props.Lat, props.Lon),
+		Formatted:    props.Formatted,
+		AddressLine1: props.AddressLine1,
+		AddressLine2: props.AddressLine2,
+		HouseNumber:  props.HouseNumber,
+		Street:       props.Street,
+		Postcode:     props.Postcode,
+		City:         props.City,
+		State:        props.State,
+		Country:      props.Country,
+		CountryCode:  props.CountryCode,
+		Distance:     props.Distance,
+		Properties:   f.Properties,
+	}, nil
+}
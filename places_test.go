@@ -2,7 +2,10 @@ package geoapify
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -126,3 +129,144 @@ func TestPlaces_APIError(t *testing.T) {
 	}
 	assertEqual(t, apiErr.StatusCode, 401)
 }
+
+func TestPlaces_InvalidCategory(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request should be sent")
+	})
+
+	_, err := client.Places().Categories("catering.restaurant.pizzas").Do(context.Background())
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument, got %v", err)
+	}
+	assertEqual(t, strings.Contains(err.Error(), `did you mean "catering.restaurant.pizza"`), true)
+
+	_, err = client.Places().Categories(CategoryCatering).WithConditions("wheelchairs").Do(context.Background())
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument, got %v", err)
+	}
+}
+
+func TestPlaces_AllowUnknownCategories(t *testing.T) {
+	var got url.Values
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query()
+		w.Write([]byte(`{"type":"FeatureCollection","features":[]}`))
+	})
+
+	_, err := client.Places().Categories(CategoryAdministrativeStateLevel).Do(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertEqual(t, got.Get("categories"), "administrative.state_level")
+
+	_, err = client.Places().Categories("catering.future_kind").
+		WithConditions("future_condition").
+		AllowUnknownCategories().
+		Do(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertEqual(t, got.Get("categories"), "catering.future_kind")
+	assertEqual(t, got.Get("conditions"), "future_condition")
+}
+
+func TestPlaces_TypedResponse(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"type":"FeatureCollection","features":[{"type":"Feature",
+			"properties":{"place_id":"p1","name":"Cafe","categories":["catering","catering.cafe"],"lat":41.87,"lon":-87.77,
+				"street":"Main St","housenumber":"5","city":"Chicago","country_code":"us","formatted":"Cafe, 5 Main St","distance":120},
+			"geometry":{"type":"Point","coordinates":[-87.77,41.87]}}]}`))
+	})
+
+	got, err := client.Places().Categories("catering").Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, len(got.Places), 1)
+	p := got.Places[0]
+	assertEqual(t, p.PlaceID, "p1")
+	assertEqual(t, p.Name, "Cafe")
+	assertEqual(t, len(p.Categories), 2)
+	assertEqual(t, p.HasCategory(CategoryCatering), true)
+	assertEqual(t, p.HasCategory(CategoryCateringRestaurant), false)
+	assertEqual(t, p.Location, LatLon(41.87, -87.77))
+	assertEqual(t, p.HouseNumber, "5")
+	assertEqual(t, p.CountryCode, "us")
+	assertEqual(t, p.Distance, 120.0)
+}
+
+func placesPage(ids ...string) []byte {
+	features := make([]string, len(ids))
+	for i, id := range ids {
+		features[i] = `{"type":"Feature","properties":{"place_id":"` + id + `","name":"` + id + `"}}`
+	}
+	return []byte(`{"type":"FeatureCollection","features":[` + strings.Join(features, ",") + `]}`)
+}
+
+func TestPlaces_All(t *testing.T) {
+	var offsets []string
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assertEqual(t, q.Get("limit"), "3")
+		offsets = append(offsets, q.Get("offset"))
+		switch q.Get("offset") {
+		case "":
+			w.Write(placesPage("a", "b", "c"))
+		case "3":
+			// The API may repeat a place across pages.
+			w.Write(placesPage("c", "d", "e"))
+		case "6":
+			w.Write(placesPage("f"))
+		}
+	})
+
+	var names []string
+	for p, err := range client.Places().Categories("catering").WithLimit(3).All(context.Background()) {
+		assertNoError(t, err)
+		names = append(names, p.Name)
+	}
+	assertEqual(t, strings.Join(names, ","), "a,b,c,d,e,f")
+	assertEqual(t, strings.Join(offsets, ","), ",3,6")
+}
+
+func TestPlaces_AllMaxResults(t *testing.T) {
+	requests := 0
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write(placesPage("a"+r.URL.Query().Get("offset"), "b"+r.URL.Query().Get("offset")))
+	})
+
+	var n int
+	for _, err := range client.Places().Categories("catering").WithLimit(2).WithOffset(10).WithMaxResults(3).All(context.Background()) {
+		assertNoError(t, err)
+		n++
+	}
+	assertEqual(t, n, 3)
+	assertEqual(t, requests, 2)
+}
+
+func TestPlaces_AllError(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "" {
+			w.Write(placesPage("a", "b"))
+			return
+		}
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"message":"Rate limit exceeded"}`))
+	})
+
+	var places, errs int
+	for _, err := range client.Places().Categories("catering").WithLimit(2).All(context.Background()) {
+		if err != nil {
+			errs++
+			apiErr, ok := IsAPIError(err)
+			if !ok {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			assertEqual(t, apiErr.StatusCode, 429)
+			continue
+		}
+		places++
+	}
+	assertEqual(t, places, 2)
+	assertEqual(t, errs, 1)
+}
@@ -0,0 +1,80 @@
+package geoapify
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	defaultPollInterval    = time.Second
+	defaultMaxPollInterval = 10 * time.Second
+)
+
+// pollConfig controls how long-running jobs are polled until they complete.
+type pollConfig struct {
+	interval    time.Duration
+	maxInterval time.Duration
+}
+
+// bounds returns the first delay and the delay cap, applying the defaults
+// for unset values.
+func (p pollConfig) bounds() (time.Duration, time.Duration) {
+	delay := p.interval
+	if delay <= 0 {
+		delay = defaultPollInterval
+	}
+	maxDelay := p.maxInterval
+	if maxDelay <= 0 {
+		maxDelay = defaultMaxPollInterval
+	}
+	if maxDelay < delay {
+		maxDelay = delay
+	}
+	return delay, maxDelay
+}
+
+// do calls fn until it reports done or returns an error. The delay between
+// calls starts at interval and doubles up to maxInterval.
+func (p pollConfig) do(ctx context.Context, fn func() (bool, error)) error {
+	return p.run(ctx, false, fn)
+}
+
+// doAfterDelay is like do, but waits one interval before the first call.
+// It suits jobs that were just submitted and are known to be pending.
+func (p pollConfig) doAfterDelay(ctx context.Context, fn func() (bool, error)) error {
+	return p.run(ctx, true, fn)
+}
+
+func (p pollConfig) run(ctx context.Context, waitFirst bool, fn func() (bool, error)) error {
+	delay, maxDelay := p.bounds()
+	if waitFirst {
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+		delay = min(delay*2, maxDelay)
+	}
+
+	for {
+		done, err := fn()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+		delay = min(delay*2, maxDelay)
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
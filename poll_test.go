@@ -0,0 +1,73 @@
+package geoapify
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPollConfig_Bounds(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    pollConfig
+		wantDelay time.Duration
+		wantMax   time.Duration
+	}{
+		{"defaults", pollConfig{}, defaultPollInterval, defaultMaxPollInterval},
+		{"interval only", pollConfig{interval: 2 * time.Second}, 2 * time.Second, defaultMaxPollInterval},
+		{"max below interval", pollConfig{interval: time.Minute, maxInterval: time.Second}, time.Minute, time.Minute},
+		{"both set", pollConfig{interval: time.Second, maxInterval: 5 * time.Second}, time.Second, 5 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, maxDelay := tt.config.bounds()
+			assertEqual(t, delay, tt.wantDelay)
+			assertEqual(t, maxDelay, tt.wantMax)
+		})
+	}
+}
+
+func TestPollConfig_DelaysGrow(t *testing.T) {
+	var calls []time.Time
+	p := pollConfig{interval: 5 * time.Millisecond}
+	err := p.do(context.Background(), func() (bool, error) {
+		calls = append(calls, time.Now())
+		return len(calls) == 5, nil
+	})
+	assertNoError(t, err)
+	assertEqual(t, len(calls), 5)
+
+	// Without a maxInterval the delays keep doubling: 5, 10, 20, 40ms.
+	want := 5 * time.Millisecond
+	for i := 1; i < len(calls); i++ {
+		if gap := calls[i].Sub(calls[i-1]); gap < want {
+			t.Errorf("delay %d = %s, want at least %s", i, gap, want)
+		}
+		want *= 2
+	}
+}
+
+func TestPollConfig_DoAfterDelay(t *testing.T) {
+	start := time.Now()
+	var first time.Duration
+	p := pollConfig{interval: 10 * time.Millisecond}
+	err := p.doAfterDelay(context.Background(), func() (bool, error) {
+		first = time.Since(start)
+		return true, nil
+	})
+	assertNoError(t, err)
+	if first < 10*time.Millisecond {
+		t.Errorf("first call after %s, want at least one interval", first)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = p.doAfterDelay(ctx, func() (bool, error) {
+		t.Error("fn should not be called after cancellation")
+		return true, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
@@ -2,9 +2,11 @@ package geoapify
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
-	"strings"
+	"strconv"
 )
 
 // PostcodeService provides access to the GeoApify Postcode API.
@@ -27,8 +29,8 @@ type PostcodeRequest struct {
 	lat      float64
 	lon      float64
 	limit    int
-	filter   []string
-	bias     []string
+	filter   []Filter
+	bias     []Bias
 	lang     string
 	format   Format
 	geometry GeometryType
@@ -41,13 +43,13 @@ func (r *PostcodeRequest) WithLimit(n int) *PostcodeRequest {
 }
 
 // WithFilter sets the result filters.
-func (r *PostcodeRequest) WithFilter(filters ...string) *PostcodeRequest {
+func (r *PostcodeRequest) WithFilter(filters ...Filter) *PostcodeRequest {
 	r.filter = filters
 	return r
 }
 
 // WithBias sets the result biases.
-func (r *PostcodeRequest) WithBias(biases ...string) *PostcodeRequest {
+func (r *PostcodeRequest) WithBias(biases ...Bias) *PostcodeRequest {
 	r.bias = biases
 	return r
 }
@@ -71,7 +73,7 @@ func (r *PostcodeRequest) WithGeometry(g GeometryType) *PostcodeRequest {
 }
 
 // Do executes the postcode request.
-func (r *PostcodeRequest) Do(ctx context.Context) (*GeoJSONFeatureCollection, error) {
+func (r *PostcodeRequest) Do(ctx context.Context) (*PostcodeResponse, error) {
 	params := url.Values{}
 
 	params.Set("lat", fmt.Sprintf("%g", r.lat))
@@ -80,11 +82,8 @@ func (r *PostcodeRequest) Do(ctx context.Context) (*GeoJSONFeatureCollection, er
 	if r.limit > 0 {
 		params.Set("limit", fmt.Sprintf("%d", r.limit))
 	}
-	if len(r.filter) > 0 {
-		params.Set("filter", strings.Join(r.filter, "|"))
-	}
-	if len(r.bias) > 0 {
-		params.Set("bias", strings.Join(r.bias, "|"))
+	if err := setFilterParams(params, r.filter, r.bias); err != nil {
+		return nil, err
 	}
 	if r.lang != "" {
 		params.Set("lang", r.lang)
@@ -96,9 +95,220 @@ func (r *PostcodeRequest) Do(ctx context.Context) (*GeoJSONFeatureCollection, er
 		params.Set("geometry", string(r.geometry))
 	}
 
-	var result GeoJSONFeatureCollection
+	var result PostcodeResponse
 	if err := r.service.client.doGet(ctx, "/v1/geocode/postcode", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
+
+// List creates a request builder that lists the postcodes inside an area.
+// Restrict the area with WithFilter or WithinBoundary.
+func (s *PostcodeService) List() *PostcodeListRequest {
+	return &PostcodeListRequest{service: s}
+}
+
+// PostcodeListRequest is a builder for postcode list API requests.
+type PostcodeListRequest struct {
+	service  *PostcodeService
+	filter   []Filter
+	bias     []Bias
+	limit    int
+	offset   int
+	lang     string
+	geometry GeometryType
+}
+
+// WithFilter restricts the postcodes to the given areas.
+func (r *PostcodeListRequest) WithFilter(filters ...Filter) *PostcodeListRequest {
+	r.filter = append(r.filter, filters...)
+	return r
+}
+
+// WithinBoundary restricts the postcodes to those inside a parent boundary,
+// identified by its place ID.
+func (r *PostcodeListRequest) WithinBoundary(placeID string) *PostcodeListRequest {
+	r.filter = append(r.filter, PlaceFilter(placeID))
+	return r
+}
+
+// WithBias sets the result biases.
+func (r *PostcodeListRequest) WithBias(biases ...Bias) *PostcodeListRequest {
+	r.bias = append(r.bias, biases...)
+	return r
+}
+
+// WithLimit sets the maximum number of results per page.
+func (r *PostcodeListRequest) WithLimit(n int) *PostcodeListRequest {
+	r.limit = n
+	return r
+}
+
+// WithOffset sets the number of results to skip.
+func (r *PostcodeListRequest) WithOffset(n int) *PostcodeListRequest {
+	r.offset = n
+	return r
+}
+
+// WithLang sets the response language.
+func (r *PostcodeListRequest) WithLang(v string) *PostcodeListRequest {
+	r.lang = v
+	return r
+}
+
+// WithGeometry sets the geometry type returned for each postcode.
+func (r *PostcodeListRequest) WithGeometry(g GeometryType) *PostcodeListRequest {
+	r.geometry = g
+	return r
+}
+
+func (r *PostcodeListRequest) params(limit, offset int) (url.Values, error) {
+	if len(r.filter) == 0 {
+		return nil, fmt.Errorf("%w: postcode list requires a filter", ErrInvalidArgument)
+	}
+	params := url.Values{}
+	if err := setFilterParams(params, r.filter, r.bias); err != nil {
+		return nil, err
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		params.Set("offset", strconv.Itoa(offset))
+	}
+	if r.lang != "" {
+		params.Set("lang", r.lang)
+	}
+	if r.geometry != "" {
+		params.Set("geometry", string(r.geometry))
+	}
+	return params, nil
+}
+
+func (r *PostcodeListRequest) page(ctx context.Context, limit, offset int) (*PostcodeResponse, error) {
+	params, err := r.params(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	var result PostcodeResponse
+	if err := r.service.client.doGet(ctx, "/v1/postcode/list", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Do fetches a single page of postcodes.
+func (r *PostcodeListRequest) Do(ctx context.Context) (*PostcodeResponse, error) {
+	return r.page(ctx, r.limit, r.offset)
+}
+
+// defaultPostcodePageSize is the page size All uses when no limit is set.
+const defaultPostcodePageSize = 100
+
+// All iterates over every postcode in the area, fetching pages of WithLimit
+// results (100 by default) starting at WithOffset. Iteration stops at the
+// first error, which is yielded with a zero Postcode.
+func (r *PostcodeListRequest) All(ctx context.Context) iter.Seq2[Postcode, error] {
+	return func(yield func(Postcode, error) bool) {
+		size := r.limit
+		if size <= 0 {
+			size = defaultPostcodePageSize
+		}
+		for offset := r.offset; ; offset += size {
+			resp, err := r.page(ctx, size, offset)
+			if err != nil {
+				yield(Postcode{}, err)
+				return
+			}
+			for _, p := range resp.Postcodes {
+				if !yield(p, nil) {
+					return
+				}
+			}
+			if len(resp.Postcodes) < size {
+				return
+			}
+		}
+	}
+}
+
+// PostcodeResponse is the response from the postcode APIs.
+type PostcodeResponse struct {
+	GeoJSONFeatureCollection
+	Postcodes []Postcode `json:"-"`
+}
+
+// Postcode is a postal code area.
+type Postcode struct {
+	Code        string
+	City        string
+	County      string
+	State       string
+	StateCode   string
+	Country     string
+	CountryCode string
+	// Location is the postcode's representative point.
+	Location Location
+	// Geometry is the postcode area. It is empty when the response was
+	// requested with GeometryPoint.
+	Geometry   MultiPolygon
+	Properties map[string]any
+}
+
+// UnmarshalJSON decodes the feature collection and builds the typed
+// postcodes.
+func (r *PostcodeResponse) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.GeoJSONFeatureCollection); err != nil {
+		return err
+	}
+	r.Postcodes = r.Postcodes[:0]
+	for _, f := range r.Features {
+		p, err := newPostcode(f)
+		if err != nil {
+			return err
+		}
+		r.Postcodes = append(r.Postcodes, p)
+	}
+	return nil
+}
+
+func newPostcode(f GeoJSONFeature) (Postcode, error) {
+	var props struct {
+		Postcode    string  `json:"postcode"`
+		City        string  `json:"city"`
+		County      string  `json:"county"`
+		State       string  `json:"state"`
+		StateCode   string  `json:"state_code"`
+		Country     string  `json:"country"`
+		CountryCode string  `json:"country_code"`
+		Lat         float64 `json:"lat"`
+		Lon         float64 `json:"lon"`
+	}
+	if err := remarshal(f.Properties, &props); err != nil {
+		return Postcode{}, fmt.Errorf("decoding postcode properties: %w", err)
+	}
+
+	p := Postcode{
+		Code:        props.Postcode,
+		City:        props.City,
+		County:      props.County,
+		State:       props.State,
+		StateCode:   props.StateCode,
+		Country:     props.Country,
+		CountryCode: props.CountryCode,
+		Location:    LatLon(props.Lat, props.Lon),
+		Properties:  f.Properties,
+	}
+
+	if loc, ok, err := f.Geometry.point(); err != nil {
+		return Postcode{}, fmt.Errorf("decoding postcode: %w", err)
+	} else if ok {
+		p.Location = loc
+	}
+	geometry, err := f.Geometry.Polygons()
+	if err != nil {
+		return Postcode{}, err
+	}
+	p.Geometry = geometry
+	return p, nil
+}
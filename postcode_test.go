@@ -37,8 +37,8 @@ func TestPostcode_AllBuilderOptions(t *testing.T) {
 	_, err := client.Postcode().
 		Search(47.2529, -122.4443).
 		WithLimit(5).
-		WithFilter("countrycode:us", "countrycode:ca").
-		WithBias("proximity:-122.0,47.0").
+		WithFilter(CountryFilter("us"), CountryFilter("ca")).
+		WithBias(RawBias("proximity:-122.0,47.0")).
 		WithLang("en").
 		WithFormat(FormatGeoJSON).
 		WithGeometry(GeometryPoint).
@@ -107,3 +107,105 @@ func TestPostcode_DefaultsOmitted(t *testing.T) {
 	_, err := client.Postcode().Search(1, 2).Do(context.Background())
 	assertNoError(t, err)
 }
+
+func TestPostcode_TypedResponse(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"type":"FeatureCollection","features":[
+			{"type":"Feature","properties":{"postcode":"98402","city":"Tacoma","county":"Pierce County","state":"Washington","state_code":"WA","country":"United States","country_code":"us","lat":47.25,"lon":-122.44},
+			 "geometry":{"type":"Polygon","coordinates":[[[-122.45,47.24],[-122.43,47.24],[-122.43,47.26],[-122.45,47.24]]]}}
+		]}`))
+	})
+
+	got, err := client.Postcode().Search(47.2529, -122.4443).WithGeometry(Geometry1000).Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, len(got.Postcodes), 1)
+
+	p := got.Postcodes[0]
+	assertEqual(t, p.Code, "98402")
+	assertEqual(t, p.City, "Tacoma")
+	assertEqual(t, p.County, "Pierce County")
+	assertEqual(t, p.StateCode, "WA")
+	assertEqual(t, p.CountryCode, "us")
+	assertEqual(t, p.Location, LatLon(47.25, -122.44))
+	assertEqual(t, len(p.Geometry), 1)
+	assertEqual(t, len(p.Geometry[0][0]), 4)
+}
+
+func TestPostcode_List(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assertEqual(t, r.URL.Path, "/v1/postcode/list")
+		q := r.URL.Query()
+		assertEqual(t, q.Get("filter"), "place:51abc|countrycode:us")
+		assertEqual(t, q.Get("limit"), "20")
+		assertEqual(t, q.Get("offset"), "40")
+		assertEqual(t, q.Get("geometry"), "point")
+		assertEqual(t, q.Get("lang"), "en")
+		w.Write([]byte(`{"type":"FeatureCollection","features":[
+			{"type":"Feature","properties":{"postcode":"98402"},"geometry":{"type":"Point","coordinates":[-122.44,47.25]}}
+		]}`))
+	})
+
+	got, err := client.Postcode().List().
+		WithinBoundary("51abc").
+		WithFilter(CountryFilter("us")).
+		WithLimit(20).
+		WithOffset(40).
+		WithGeometry(GeometryPoint).
+		WithLang("en").
+		Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, len(got.Postcodes), 1)
+	assertEqual(t, got.Postcodes[0].Code, "98402")
+	assertEqual(t, got.Postcodes[0].Location, LonLat(-122.44, 47.25))
+	assertEqual(t, len(got.Postcodes[0].Geometry), 0)
+}
+
+func TestPostcode_ListRequiresFilter(t *testing.T) {
+	client := NewClient("test-api-key")
+
+	_, err := client.Postcode().List().Do(context.Background())
+	assertError(t, err)
+}
+
+func TestPostcode_ListAll(t *testing.T) {
+	var offsets []string
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assertEqual(t, q.Get("limit"), "2")
+		offsets = append(offsets, q.Get("offset"))
+		switch q.Get("offset") {
+		case "":
+			w.Write([]byte(`{"type":"FeatureCollection","features":[
+				{"type":"Feature","properties":{"postcode":"1"}},
+				{"type":"Feature","properties":{"postcode":"2"}}]}`))
+		case "2":
+			w.Write([]byte(`{"type":"FeatureCollection","features":[
+				{"type":"Feature","properties":{"postcode":"3"}}]}`))
+		default:
+			t.Errorf("unexpected offset %s", q.Get("offset"))
+		}
+	})
+
+	var codes []string
+	for p, err := range client.Postcode().List().WithFilter(CountryFilter("de")).WithLimit(2).All(context.Background()) {
+		assertNoError(t, err)
+		codes = append(codes, p.Code)
+	}
+	assertEqual(t, len(codes), 3)
+	assertEqual(t, codes[2], "3")
+	assertEqual(t, len(offsets), 2)
+}
+
+func TestPostcode_ListAllError(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message":"Invalid API key"}`))
+	})
+
+	var errs int
+	for _, err := range client.Postcode().List().WithFilter(CountryFilter("de")).All(context.Background()) {
+		assertError(t, err)
+		errs++
+	}
+	assertEqual(t, errs, 1)
+}
@@ -0,0 +1,67 @@
+package geoapify
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket shared by every request of a client.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// WithRateLimit limits the client to requestsPerSecond, allowing bursts of
+// up to burst requests. Every HTTP attempt counts, including retries, and
+// the limit is shared by all goroutines using the client.
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(c *Client) {
+		if requestsPerSecond <= 0 {
+			c.limiter = nil
+			return
+		}
+		b := float64(max(burst, 1))
+		c.limiter = &rateLimiter{rate: requestsPerSecond, burst: b, tokens: b}
+	}
+}
+
+// wait blocks until the next request may be sent. A nil limiter never
+// blocks.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if !l.last.IsZero() {
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	l.tokens--
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Give the reserved slot back to the other requests.
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
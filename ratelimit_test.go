@@ -0,0 +1,49 @@
+package geoapify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimit_SpacesRequests(t *testing.T) {
+	var times []time.Time
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		times = append(times, time.Now())
+		w.Write(mustJSON(t, GeocodingResponse{}))
+	})
+	WithRateLimit(50, 2)(client)
+
+	for range 4 {
+		_, err := client.Geocoding().Search("x").Do(context.Background())
+		assertNoError(t, err)
+	}
+	// Two requests fit in the burst; the next two wait 20ms each.
+	if elapsed := times[3].Sub(times[0]); elapsed < 30*time.Millisecond {
+		t.Errorf("expected requests to be spaced out, took %v", elapsed)
+	}
+}
+
+func TestRateLimit_Cancel(t *testing.T) {
+	l := &rateLimiter{rate: 1, burst: 1, tokens: 0, last: time.Now()}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := l.wait(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if l.tokens < -0.5 {
+		t.Errorf("expected the reserved token to be returned, got %v", l.tokens)
+	}
+}
+
+func TestRateLimit_Disabled(t *testing.T) {
+	client := NewClient("key", WithRateLimit(0, 1))
+	if client.limiter != nil {
+		t.Error("expected no limiter")
+	}
+	assertNoError(t, client.limiter.wait(context.Background()))
+}
@@ -0,0 +1,217 @@
+package geoapify
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const defaultReachableLimit = 20
+
+// ReachablePlacesRequest is a builder for a travel-time-constrained places
+// search. It calculates an isoline around the origin, searches for places
+// inside it and ranks them by distance or travel time.
+type ReachablePlacesRequest struct {
+	client      *Client
+	origin      Location
+	mode        TravelMode
+	within      time.Duration
+	categories  []Category
+	conditions  []Condition
+	name        string
+	lang        string
+	limit       int
+	travelTimes bool
+	poll        pollConfig
+	unchecked   bool
+}
+
+// WithinTravelTime creates a request for places of the given categories
+// that can be reached from origin within the given travel time, such as
+// cafés within 10 minutes' walk.
+func (s *PlacesService) WithinTravelTime(origin Location, mode TravelMode, within time.Duration, categories ...Category) *ReachablePlacesRequest {
+	return &ReachablePlacesRequest{
+		client:     s.client,
+		origin:     origin,
+		mode:       mode,
+		within:     within,
+		categories: categories,
+	}
+}
+
+// WithConditions adds conditions that every place must satisfy.
+func (r *ReachablePlacesRequest) WithConditions(conditions ...Condition) *ReachablePlacesRequest {
+	r.conditions = append(r.conditions, conditions...)
+	return r
+}
+
+// AllowUnknownCategories sends categories and conditions as given instead
+// of checking them against the lists known to this package.
+func (r *ReachablePlacesRequest) AllowUnknownCategories() *ReachablePlacesRequest {
+	r.unchecked = true
+	return r
+}
+
+// WithName sets a name filter for the places search.
+func (r *ReachablePlacesRequest) WithName(v string) *ReachablePlacesRequest {
+	r.name = v
+	return r
+}
+
+// WithLang sets the language of the results.
+func (r *ReachablePlacesRequest) WithLang(lang string) *ReachablePlacesRequest {
+	r.lang = lang
+	return r
+}
+
+// WithLimit sets the maximum number of places to fetch (default 20). Places
+// are ranked among the fetched ones, which the API returns closest first.
+func (r *ReachablePlacesRequest) WithLimit(n int) *ReachablePlacesRequest {
+	r.limit = n
+	return r
+}
+
+// WithTravelTimes computes the actual travel time to every place with the
+// Route Matrix API and ranks places by it instead of straight-line distance.
+// Places the router cannot reach are dropped.
+func (r *ReachablePlacesRequest) WithTravelTimes() *ReachablePlacesRequest {
+	r.travelTimes = true
+	return r
+}
+
+// WithPollInterval sets how often a pending isoline is polled. The interval
+// doubles after each poll up to maxInterval.
+func (r *ReachablePlacesRequest) WithPollInterval(interval, maxInterval time.Duration) *ReachablePlacesRequest {
+	r.poll = pollConfig{interval: interval, maxInterval: maxInterval}
+	return r
+}
+
+// Do calculates the isoline, waiting for it if it is calculated
+// asynchronously, then fetches and ranks the places inside it.
+func (r *ReachablePlacesRequest) Do(ctx context.Context) (*ReachablePlacesResponse, error) {
+	if r.mode == "" {
+		return nil, fmt.Errorf("%w: travel mode is required", ErrInvalidArgument)
+	}
+	seconds := int(r.within / time.Second)
+	if seconds <= 0 {
+		return nil, fmt.Errorf("%w: travel time must be at least one second, got %s", ErrInvalidArgument, r.within)
+	}
+	if len(r.categories) == 0 {
+		return nil, fmt.Errorf("%w: at least one category is required", ErrInvalidArgument)
+	}
+	if !r.unchecked {
+		if err := validateCategories(r.categories); err != nil {
+			return nil, err
+		}
+		if err := validateConditions(r.conditions); err != nil {
+			return nil, err
+		}
+	}
+
+	iso := r.client.Isolines().At(r.origin.Lat, r.origin.Lon).
+		WithType(IsolineTime).
+		WithMode(r.mode).
+		WithRange(seconds)
+	iso.poll = r.poll
+	area, err := iso.DoAndWait(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("calculating isoline: %w", err)
+	}
+	if area.ID == "" {
+		return nil, fmt.Errorf("geoapify: isoline response has no id")
+	}
+
+	limit := r.limit
+	if limit <= 0 {
+		limit = defaultReachableLimit
+	}
+	search := r.client.Places().Categories(r.categories...).
+		WithConditions(r.conditions...).
+		WithFilter(GeometryFilter(area.ID)).
+		WithBias(r.origin.ProximityBias()).
+		WithName(r.name).
+		WithLang(r.lang).
+		WithLimit(min(limit, defaultPlacesPageSize)).
+		WithMaxResults(limit)
+	search.unchecked = r.unchecked
+
+	result := &ReachablePlacesResponse{Isoline: area}
+	for p, err := range search.All(ctx) {
+		if err != nil {
+			return nil, fmt.Errorf("searching places: %w", err)
+		}
+		result.Places = append(result.Places, ReachablePlace{
+			Place:                p,
+			StraightLineDistance: r.origin.HaversineDistance(p.Location),
+		})
+	}
+
+	if r.travelTimes && len(result.Places) > 0 {
+		if err := r.addTravelTimes(ctx, result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	slices.SortStableFunc(result.Places, func(a, b ReachablePlace) int {
+		return cmp.Compare(a.StraightLineDistance, b.StraightLineDistance)
+	})
+	return result, nil
+}
+
+// addTravelTimes fills in travel times from a one-to-many route matrix,
+// drops unreachable places and sorts the rest by travel time.
+func (r *ReachablePlacesRequest) addTravelTimes(ctx context.Context, result *ReachablePlacesResponse) error {
+	targets := make([]Location, len(result.Places))
+	for i, p := range result.Places {
+		targets[i] = p.Location
+	}
+	entries, err := r.client.RouteMatrix().Calculate().
+		Sources(r.origin).
+		Targets(targets...).
+		WithMode(r.mode).
+		doOneToMany(ctx, maxMatrixTargets)
+	if err != nil {
+		return err
+	}
+
+	reachable := result.Places[:0]
+	for i, p := range result.Places {
+		e := entries[i]
+		if e.Unreachable {
+			continue
+		}
+		p.TravelTime = time.Duration(e.Time * float64(time.Second))
+		p.TravelDistance = e.Distance
+		reachable = append(reachable, p)
+	}
+	result.Places = reachable
+	slices.SortStableFunc(result.Places, func(a, b ReachablePlace) int {
+		return cmp.Compare(a.TravelTime, b.TravelTime)
+	})
+	return nil
+}
+
+// ReachablePlacesResponse is the result of a travel-time-constrained
+// places search.
+type ReachablePlacesResponse struct {
+	// Isoline is the reachable area the places were searched in. Its ID can
+	// be reused in a GeometryFilter.
+	Isoline *IsolineResponse
+	// Places are ranked by travel time when WithTravelTimes was set, and by
+	// straight-line distance otherwise.
+	Places []ReachablePlace
+}
+
+// ReachablePlace is a place found by a travel-time-constrained search.
+type ReachablePlace struct {
+	Place
+	// StraightLineDistance is the great-circle distance in meters from the
+	// origin.
+	StraightLineDistance float64
+	// TravelTime and TravelDistance (in meters) are only set when
+	// WithTravelTimes was used.
+	TravelTime     time.Duration
+	TravelDistance float64
+}
@@ -0,0 +1,114 @@
+package geoapify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func reachableTestServer(t *testing.T, matrix func(w http.ResponseWriter, b routeMatrixBody)) *Client {
+	t.Helper()
+	var isolineCalls atomic.Int32
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/isoline":
+			q := r.URL.Query()
+			if isolineCalls.Add(1) == 1 {
+				assertEqual(t, q.Get("type"), "time")
+				assertEqual(t, q.Get("mode"), "walk")
+				assertEqual(t, q.Get("range"), "600")
+				w.Write([]byte(`{"id":"iso1","status":"pending"}`))
+				return
+			}
+			assertEqual(t, q.Get("id"), "iso1")
+			w.Write([]byte(`{"type":"FeatureCollection","features":[{"type":"Feature","properties":{"id":"iso1","range":600},
+				"geometry":{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}}]}`))
+		case "/v2/places":
+			q := r.URL.Query()
+			assertEqual(t, q.Get("categories"), "catering.cafe")
+			assertEqual(t, q.Get("filter"), "geometry:iso1")
+			assertEqual(t, q.Get("bias"), "proximity:0,0")
+			w.Write([]byte(`{"type":"FeatureCollection","features":[
+				{"type":"Feature","properties":{"place_id":"far","lat":0.02,"lon":0}},
+				{"type":"Feature","properties":{"place_id":"near","lat":0.01,"lon":0}},
+				{"type":"Feature","properties":{"place_id":"island","lat":0.005,"lon":0}}]}`))
+		case "/v1/routematrix":
+			var b routeMatrixBody
+			assertNoError(t, json.NewDecoder(r.Body).Decode(&b))
+			matrix(w, b)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+	return client
+}
+
+func TestPlaces_WithinTravelTime(t *testing.T) {
+	client := reachableTestServer(t, func(w http.ResponseWriter, b routeMatrixBody) {
+		t.Error("route matrix should not be called")
+	})
+
+	got, err := client.Places().
+		WithinTravelTime(LatLon(0, 0), ModeWalk, 10*time.Minute, CategoryCateringCafe).
+		WithPollInterval(time.Millisecond, time.Millisecond).
+		Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, got.Isoline.ID, "iso1")
+	assertEqual(t, len(got.Places), 3)
+	assertEqual(t, got.Places[0].PlaceID, "island")
+	assertEqual(t, got.Places[1].PlaceID, "near")
+	assertEqual(t, got.Places[2].PlaceID, "far")
+	assertNear(t, got.Places[1].StraightLineDistance, 1111.95, 1)
+	assertEqual(t, got.Places[1].TravelTime, time.Duration(0))
+}
+
+func TestPlaces_WithinTravelTime_TravelTimes(t *testing.T) {
+	client := reachableTestServer(t, func(w http.ResponseWriter, b routeMatrixBody) {
+		assertEqual(t, b.Mode, ModeWalk)
+		assertEqual(t, len(b.Sources), 1)
+		assertEqual(t, len(b.Targets), 3)
+		// "far" is quicker to reach than "near"; "island" has no route.
+		w.Write([]byte(`{"sources_to_targets":[[
+			{"source_index":0,"target_index":0,"time":300,"distance":2300},
+			{"source_index":0,"target_index":1,"time":500,"distance":1500},
+			{"source_index":0,"target_index":2,"time":null,"distance":null}]]}`))
+	})
+
+	got, err := client.Places().
+		WithinTravelTime(LatLon(0, 0), ModeWalk, 10*time.Minute, CategoryCateringCafe).
+		WithPollInterval(time.Millisecond, time.Millisecond).
+		WithTravelTimes().
+		Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, len(got.Places), 2)
+	assertEqual(t, got.Places[0].PlaceID, "far")
+	assertEqual(t, got.Places[0].TravelTime, 300*time.Second)
+	assertEqual(t, got.Places[0].TravelDistance, 2300.0)
+	assertEqual(t, got.Places[1].PlaceID, "near")
+}
+
+func TestPlaces_WithinTravelTime_Validation(t *testing.T) {
+	client := NewClient("key")
+	tests := []struct {
+		name string
+		req  *ReachablePlacesRequest
+	}{
+		{"no mode", client.Places().WithinTravelTime(LatLon(0, 0), "", time.Minute, CategoryCatering)},
+		{"no time", client.Places().WithinTravelTime(LatLon(0, 0), ModeWalk, 0, CategoryCatering)},
+		{"no categories", client.Places().WithinTravelTime(LatLon(0, 0), ModeWalk, time.Minute)},
+		{"unknown category", client.Places().WithinTravelTime(LatLon(0, 0), ModeWalk, time.Minute, "catering.caffe")},
+		{"unknown condition", client.Places().WithinTravelTime(LatLon(0, 0), ModeWalk, time.Minute, CategoryCatering).WithConditions("wheelchairs")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.req.Do(context.Background())
+			if !errors.Is(err, ErrInvalidArgument) {
+				t.Fatalf("expected ErrInvalidArgument, got %v", err)
+			}
+		})
+	}
+}
@@ -50,8 +50,7 @@ func (r *ReverseGeocodingRequest) WithFormat(f Format) *ReverseGeocodingRequest
 	return r
 }
 
-// Do executes the reverse geocoding request.
-func (r *ReverseGeocodingRequest) Do(ctx context.Context) (*GeocodingResponse, error) {
+func (r *ReverseGeocodingRequest) params() (url.Values, error) {
 	params := url.Values{}
 	params.Set("lat", fmt.Sprintf("%f", r.lat))
 	params.Set("lon", fmt.Sprintf("%f", r.lon))
@@ -68,6 +67,15 @@ func (r *ReverseGeocodingRequest) Do(ctx context.Context) (*GeocodingResponse, e
 	if r.format != "" {
 		params.Set("format", string(r.format))
 	}
+	return params, nil
+}
+
+// Do executes the reverse geocoding request.
+func (r *ReverseGeocodingRequest) Do(ctx context.Context) (*GeocodingResponse, error) {
+	params, err := r.params()
+	if err != nil {
+		return nil, err
+	}
 
 	var resp GeocodingResponse
 	if err := r.client.doGet(ctx, "/v1/geocode/reverse", params, &resp); err != nil {
@@ -2,8 +2,14 @@ package geoapify
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 )
 
+// maxMatrixTargets is the number of targets sent per request when a
+// one-to-many matrix is split into chunks.
+const maxMatrixTargets = 500
+
 // RouteMatrixService provides access to the GeoApify Route Matrix API.
 type RouteMatrixService struct {
 	client *Client
@@ -105,6 +111,46 @@ func (r *RouteMatrixRequest) Do(ctx context.Context) (*RouteMatrixResponse, erro
 	return &result, nil
 }
 
+// doOneToMany computes the matrix from the single source to every target,
+// splitting the targets into requests of at most chunk targets. The returned
+// entries are in target order and their TargetIndex refers to r.targets.
+func (r *RouteMatrixRequest) doOneToMany(ctx context.Context, chunk int) ([]RouteMatrixEntry, error) {
+	if len(r.sources) != 1 {
+		return nil, fmt.Errorf("%w: one-to-many matrix needs exactly one source, got %d", ErrInvalidArgument, len(r.sources))
+	}
+	if chunk <= 0 {
+		chunk = maxMatrixTargets
+	}
+	entries := make([]RouteMatrixEntry, 0, len(r.targets))
+	for start := 0; start < len(r.targets); start += chunk {
+		end := min(start+chunk, len(r.targets))
+		part := *r
+		part.targets = r.targets[start:end]
+		resp, err := part.Do(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("route matrix for targets %d-%d: %w", start, end-1, err)
+		}
+		row := make([]RouteMatrixEntry, end-start)
+		found := make([]bool, len(row))
+		if len(resp.SourcesToTargets) > 0 {
+			for _, e := range resp.SourcesToTargets[0] {
+				if e.TargetIndex >= 0 && e.TargetIndex < len(row) {
+					row[e.TargetIndex] = e
+					found[e.TargetIndex] = true
+				}
+			}
+		}
+		for i := range row {
+			if !found[i] {
+				return nil, fmt.Errorf("geoapify: route matrix response has no entry for target %d", start+i)
+			}
+			row[i].TargetIndex = start + i
+		}
+		entries = append(entries, row...)
+	}
+	return entries, nil
+}
+
 func toRouteMatrixLocs(locs []Location) []routeMatrixLoc {
 	out := make([]routeMatrixLoc, len(locs))
 	for i, l := range locs {
@@ -153,4 +199,33 @@ type RouteMatrixEntry struct {
 	Time        float64 `json:"time"`
 	SourceIndex int     `json:"source_index"`
 	TargetIndex int     `json:"target_index"`
+	// Unreachable is set when the API found no route to the target, which
+	// it reports with a null distance and time.
+	Unreachable bool `json:"-"`
+}
+
+// UnmarshalJSON implements custom unmarshalling for RouteMatrixEntry,
+// recording null distances and times as Unreachable.
+func (e *RouteMatrixEntry) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Distance    *float64 `json:"distance"`
+		Time        *float64 `json:"time"`
+		SourceIndex int      `json:"source_index"`
+		TargetIndex int      `json:"target_index"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*e = RouteMatrixEntry{
+		SourceIndex: raw.SourceIndex,
+		TargetIndex: raw.TargetIndex,
+		Unreachable: raw.Distance == nil || raw.Time == nil,
+	}
+	if raw.Distance != nil {
+		e.Distance = *raw.Distance
+	}
+	if raw.Time != nil {
+		e.Time = *raw.Time
+	}
+	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -151,3 +152,59 @@ func TestRouteMatrix_ErrorHandling(t *testing.T) {
 	assertEqual(t, apiErr.StatusCode, 400)
 	assertEqual(t, apiErr.Message, "Invalid sources")
 }
+
+func TestRouteMatrix_OneToManyChunks(t *testing.T) {
+	var requests int
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var b routeMatrixBody
+		assertNoError(t, json.NewDecoder(r.Body).Decode(&b))
+		assertEqual(t, len(b.Sources), 1)
+		row := make([]RouteMatrixEntry, len(b.Targets))
+		for i, tgt := range b.Targets {
+			row[i] = RouteMatrixEntry{TargetIndex: i, Time: tgt.Location[1], Distance: tgt.Location[1] * 10}
+		}
+		w.Write(mustJSON(t, RouteMatrixResponse{SourcesToTargets: [][]RouteMatrixEntry{row}}))
+	})
+
+	targets := []Location{LatLon(1, 0), LatLon(2, 0), LatLon(3, 0), LatLon(4, 0), LatLon(5, 0)}
+	entries, err := client.RouteMatrix().Calculate().
+		Sources(LatLon(0, 0)).
+		Targets(targets...).
+		WithMode(ModeDrive).
+		doOneToMany(context.Background(), 2)
+	assertNoError(t, err)
+	assertEqual(t, requests, 3)
+	assertEqual(t, len(entries), 5)
+	for i, e := range entries {
+		assertEqual(t, e.TargetIndex, i)
+		assertEqual(t, e.Time, float64(i+1))
+	}
+
+	_, err = client.RouteMatrix().Calculate().Targets(targets...).doOneToMany(context.Background(), 2)
+	assertError(t, err)
+}
+
+func TestRouteMatrixEntry_Unreachable(t *testing.T) {
+	var resp RouteMatrixResponse
+	err := json.Unmarshal([]byte(`{"sources_to_targets":[[
+		{"source_index":0,"target_index":0,"time":0,"distance":0},
+		{"source_index":0,"target_index":1,"time":null,"distance":null}]]}`), &resp)
+	assertNoError(t, err)
+	assertEqual(t, resp.SourcesToTargets[0][0].Unreachable, false)
+	assertEqual(t, resp.SourcesToTargets[0][1].Unreachable, true)
+	assertEqual(t, resp.SourcesToTargets[0][1].TargetIndex, 1)
+}
+
+func TestRouteMatrix_OneToManyMissingTarget(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"sources_to_targets":[[{"source_index":0,"target_index":0,"time":10,"distance":100}]]}`))
+	})
+
+	_, err := client.RouteMatrix().Calculate().
+		Sources(LatLon(0, 0)).
+		Targets(LatLon(1, 0), LatLon(2, 0)).
+		doOneToMany(context.Background(), 10)
+	assertError(t, err)
+	assertEqual(t, strings.Contains(err.Error(), "no entry for target 1"), true)
+}
@@ -2,6 +2,7 @@ package geoapify
 
 import (
 	"context"
+	"encoding/json"
 )
 
 // RoutePlannerService provides access to the GeoApify Route Planner (VRP) API.
@@ -206,14 +207,107 @@ type PlannerLocation struct {
 type RoutePlannerResponse struct {
 	Properties map[string]any       `json:"properties,omitempty"`
 	Agents     []PlannerAgentResult `json:"agents,omitempty"`
+	Mode       TravelMode           `json:"mode,omitempty"`
+	// Params echoes the input the plan was computed for.
+	Params *PlannerParams `json:"params,omitempty"`
+	// Issues lists the agents, jobs and shipments that could not be scheduled.
+	Issues *PlannerIssues `json:"issues,omitempty"`
+}
+
+// UnmarshalJSON implements custom unmarshalling for RoutePlannerResponse.
+// The API returns a GeoJSON FeatureCollection with one feature per agent;
+// each feature is converted into an entry of Agents, and the mode, params
+// and issues are lifted out of the collection properties.
+func (r *RoutePlannerResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Properties map[string]any       `json:"properties,omitempty"`
+		Agents     []PlannerAgentResult `json:"agents,omitempty"`
+		Mode       TravelMode           `json:"mode,omitempty"`
+		Params     *PlannerParams       `json:"params,omitempty"`
+		Issues     *PlannerIssues       `json:"issues,omitempty"`
+		Features   []plannerFeature     `json:"features,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	r.Properties = raw.Properties
+	r.Agents = raw.Agents
+	r.Mode = raw.Mode
+	r.Params = raw.Params
+	r.Issues = raw.Issues
+
+	if raw.Properties != nil {
+		var props struct {
+			Mode   TravelMode     `json:"mode,omitempty"`
+			Params *PlannerParams `json:"params,omitempty"`
+			Issues *PlannerIssues `json:"issues,omitempty"`
+		}
+		b, err := json.Marshal(raw.Properties)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(b, &props); err != nil {
+			return err
+		}
+		if r.Mode == "" {
+			r.Mode = props.Mode
+		}
+		if r.Params == nil {
+			r.Params = props.Params
+		}
+		if r.Issues == nil {
+			r.Issues = props.Issues
+		}
+	}
+
+	for _, f := range raw.Features {
+		agent := f.Properties
+		geometry, err := decodeRouteGeometry(f.Geometry)
+		if err != nil {
+			return err
+		}
+		agent.Geometry = geometry
+		r.Agents = append(r.Agents, agent)
+	}
+	return nil
+}
+
+type plannerFeature struct {
+	Properties PlannerAgentResult `json:"properties"`
+	Geometry   routeGeometry      `json:"geometry"`
+}
+
+// PlannerParams is the route planner input echoed back in the response.
+type PlannerParams struct {
+	Mode      TravelMode        `json:"mode,omitempty"`
+	Agents    []PlannerAgent    `json:"agents,omitempty"`
+	Jobs      []PlannerJob      `json:"jobs,omitempty"`
+	Shipments []PlannerShipment `json:"shipments,omitempty"`
+	Locations []PlannerLocation `json:"locations,omitempty"`
+}
+
+// PlannerIssues lists the input indices that could not be scheduled.
+type PlannerIssues struct {
+	UnassignedAgents    []int `json:"unassigned_agents,omitempty"`
+	UnassignedJobs      []int `json:"unassigned_jobs,omitempty"`
+	UnassignedShipments []int `json:"unassigned_shipments,omitempty"`
 }
 
 // PlannerAgentResult represents the result for a single agent.
 type PlannerAgentResult struct {
 	AgentIndex int                `json:"agent_index"`
+	AgentID    string             `json:"agent_id,omitempty"`
 	Route      []PlannerRouteStep `json:"route,omitempty"`
 	Distance   float64            `json:"distance"`
 	Time       float64            `json:"time"`
+	StartTime  float64            `json:"start_time,omitempty"`
+	EndTime    float64            `json:"end_time,omitempty"`
+	Actions    []PlannerAction    `json:"actions,omitempty"`
+	Waypoints  []PlannerWaypoint  `json:"waypoints,omitempty"`
+	Legs       []PlannerLeg       `json:"legs,omitempty"`
+	// Geometry holds one polyline per leg.
+	Geometry [][]Location `json:"geometry,omitempty"`
 }
 
 // PlannerRouteStep represents a step in an agent's route.
@@ -223,3 +317,142 @@ type PlannerRouteStep struct {
 	Distance float64 `json:"distance,omitempty"`
 	Time     float64 `json:"time,omitempty"`
 }
+
+// PlannerActionType represents the kind of a scheduled agent action.
+type PlannerActionType string
+
+const (
+	ActionStart    PlannerActionType = "start"
+	ActionJob      PlannerActionType = "job"
+	ActionPickup   PlannerActionType = "pickup"
+	ActionDelivery PlannerActionType = "delivery"
+	ActionBreak    PlannerActionType = "break"
+	ActionEnd      PlannerActionType = "end"
+)
+
+// PlannerAction represents a single scheduled action of an agent.
+type PlannerAction struct {
+	Index         int               `json:"index"`
+	Type          PlannerActionType `json:"type"`
+	StartTime     float64           `json:"start_time"`
+	Duration      float64           `json:"duration"`
+	WaypointIndex *int              `json:"waypoint_index,omitempty"`
+	JobIndex      *int              `json:"job_index,omitempty"`
+	JobID         string            `json:"job_id,omitempty"`
+	ShipmentIndex *int              `json:"shipment_index,omitempty"`
+	ShipmentID    string            `json:"shipment_id,omitempty"`
+}
+
+// PlannerWaypoint represents a stop visited by an agent.
+type PlannerWaypoint struct {
+	OriginalLocation      [2]float64      `json:"original_location"`
+	OriginalLocationIndex *int            `json:"original_location_index,omitempty"`
+	OriginalLocationID    string          `json:"original_location_id,omitempty"`
+	Location              [2]float64      `json:"location"`
+	StartTime             float64         `json:"start_time"`
+	Duration              float64         `json:"duration"`
+	Actions               []PlannerAction `json:"actions,omitempty"`
+	PrevLegIndex          *int            `json:"prev_leg_index,omitempty"`
+	NextLegIndex          *int            `json:"next_leg_index,omitempty"`
+}
+
+// PlannerLeg represents the travel between two consecutive waypoints.
+type PlannerLeg struct {
+	Time              float64   `json:"time"`
+	Distance          float64   `json:"distance"`
+	FromWaypointIndex int       `json:"from_waypoint_index"`
+	ToWaypointIndex   int       `json:"to_waypoint_index"`
+	Steps             []LegStep `json:"steps,omitempty"`
+}
+
+// RoutePlannerSummary aggregates a route planner result.
+type RoutePlannerSummary struct {
+	Agents        []PlannerAgentSummary
+	TotalDistance float64
+	TotalTime     float64
+	// Unassigned lists everything that could not be scheduled.
+	Unassigned []PlannerUnassigned
+}
+
+// PlannerAgentSummary contains the totals for a single agent.
+type PlannerAgentSummary struct {
+	AgentIndex  int
+	AgentID     string
+	Distance    float64
+	Time        float64
+	ServiceTime float64
+	Jobs        int
+	Pickups     int
+	Deliveries  int
+	Breaks      int
+}
+
+// PlannerUnassigned identifies an input item that could not be scheduled.
+type PlannerUnassigned struct {
+	// Kind is one of "agent", "job" or "shipment".
+	Kind  string
+	Index int
+	// ID is the input ID, when it could be resolved from the echoed params.
+	ID string
+}
+
+// Summary returns per-agent totals and the list of unscheduled items.
+func (r *RoutePlannerResponse) Summary() RoutePlannerSummary {
+	var s RoutePlannerSummary
+	for _, a := range r.Agents {
+		as := PlannerAgentSummary{
+			AgentIndex: a.AgentIndex,
+			AgentID:    a.AgentID,
+			Distance:   a.Distance,
+			Time:       a.Time,
+		}
+		if as.AgentID == "" && r.Params != nil && a.AgentIndex >= 0 && a.AgentIndex < len(r.Params.Agents) {
+			as.AgentID = r.Params.Agents[a.AgentIndex].ID
+		}
+		for _, act := range a.Actions {
+			switch act.Type {
+			case ActionJob:
+				as.Jobs++
+			case ActionPickup:
+				as.Pickups++
+			case ActionDelivery:
+				as.Deliveries++
+			case ActionBreak:
+				as.Breaks++
+				continue
+			default:
+				continue
+			}
+			as.ServiceTime += act.Duration
+		}
+		s.TotalDistance += as.Distance
+		s.TotalTime += as.Time
+		s.Agents = append(s.Agents, as)
+	}
+
+	if r.Issues == nil {
+		return s
+	}
+	for _, i := range r.Issues.UnassignedAgents {
+		u := PlannerUnassigned{Kind: "agent", Index: i}
+		if r.Params != nil && i >= 0 && i < len(r.Params.Agents) {
+			u.ID = r.Params.Agents[i].ID
+		}
+		s.Unassigned = append(s.Unassigned, u)
+	}
+	for _, i := range r.Issues.UnassignedJobs {
+		u := PlannerUnassigned{Kind: "job", Index: i}
+		if r.Params != nil && i >= 0 && i < len(r.Params.Jobs) {
+			u.ID = r.Params.Jobs[i].ID
+		}
+		s.Unassigned = append(s.Unassigned, u)
+	}
+	for _, i := range r.Issues.UnassignedShipments {
+		u := PlannerUnassigned{Kind: "shipment", Index: i}
+		if r.Params != nil && i >= 0 && i < len(r.Params.Shipments) {
+			u.ID = r.Params.Shipments[i].ID
+		}
+		s.Unassigned = append(s.Unassigned, u)
+	}
+	return s
+}
@@ -216,3 +216,78 @@ func TestRoutePlanner_ErrorHandling(t *testing.T) {
 	assertEqual(t, apiErr.StatusCode, 400)
 	assertEqual(t, apiErr.Message, "No agents provided")
 }
+
+func TestRoutePlanner_FeatureCollectionResponse(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"type":"FeatureCollection",
+			"properties":{
+				"mode":"drive",
+				"params":{
+					"mode":"drive",
+					"agents":[{"id":"van-1","start_location":[0,0]},{"id":"van-2","start_location":[5,5]}],
+					"jobs":[{"id":"j1","location":[1,1]},{"id":"j2","location":[9,9]}]
+				},
+				"issues":{"unassigned_agents":[1],"unassigned_jobs":[1]}
+			},
+			"features":[{
+				"type":"Feature",
+				"geometry":{"type":"MultiLineString","coordinates":[[[0,0],[1,1]],[[1,1],[0,0]]]},
+				"properties":{
+					"agent_index":0,"distance":2000,"time":400,"start_time":0,"end_time":700,
+					"actions":[
+						{"index":0,"type":"start","start_time":0,"duration":0,"waypoint_index":0},
+						{"index":1,"type":"job","start_time":200,"duration":300,"job_index":0,"waypoint_index":1},
+						{"index":2,"type":"break","start_time":500,"duration":60},
+						{"index":3,"type":"end","start_time":700,"duration":0,"waypoint_index":2}
+					],
+					"waypoints":[
+						{"original_location":[0,0],"location":[0,0],"start_time":0,"duration":0,"next_leg_index":0},
+						{"original_location":[1,1],"location":[1.001,1],"start_time":200,"duration":300,"prev_leg_index":0,"next_leg_index":1}
+					],
+					"legs":[
+						{"time":200,"distance":1000,"from_waypoint_index":0,"to_waypoint_index":1,"steps":[{"from_index":0,"to_index":1,"time":200,"distance":1000}]},
+						{"time":200,"distance":1000,"from_waypoint_index":1,"to_waypoint_index":2}
+					]
+				}
+			}]
+		}`))
+	})
+
+	got, err := client.RoutePlanner().Plan().
+		WithMode(ModeDrive).
+		Do(context.Background())
+	assertNoError(t, err)
+
+	assertEqual(t, got.Mode, ModeDrive)
+	assertEqual(t, len(got.Params.Jobs), 2)
+	assertEqual(t, len(got.Issues.UnassignedJobs), 1)
+
+	assertEqual(t, len(got.Agents), 1)
+	agent := got.Agents[0]
+	assertEqual(t, agent.EndTime, 700.0)
+	assertEqual(t, len(agent.Actions), 4)
+	assertEqual(t, agent.Actions[1].Type, ActionJob)
+	assertEqual(t, *agent.Actions[1].JobIndex, 0)
+	assertEqual(t, agent.Actions[1].StartTime, 200.0)
+	assertEqual(t, *agent.Actions[3].WaypointIndex, 2)
+	assertEqual(t, len(agent.Waypoints), 2)
+	assertEqual(t, agent.Waypoints[1].Location, [2]float64{1.001, 1})
+	assertEqual(t, *agent.Waypoints[1].PrevLegIndex, 0)
+	assertEqual(t, len(agent.Legs), 2)
+	assertEqual(t, agent.Legs[0].ToWaypointIndex, 1)
+	assertEqual(t, len(agent.Legs[0].Steps), 1)
+	assertEqual(t, len(agent.Geometry), 2)
+	assertEqual(t, agent.Geometry[0][1], LonLat(1, 1))
+
+	summary := got.Summary()
+	assertEqual(t, len(summary.Agents), 1)
+	assertEqual(t, summary.Agents[0].AgentID, "van-1")
+	assertEqual(t, summary.Agents[0].Jobs, 1)
+	assertEqual(t, summary.Agents[0].Breaks, 1)
+	assertEqual(t, summary.Agents[0].ServiceTime, 300.0)
+	assertEqual(t, summary.TotalDistance, 2000.0)
+	assertEqual(t, len(summary.Unassigned), 2)
+	assertEqual(t, summary.Unassigned[0], PlannerUnassigned{Kind: "agent", Index: 1, ID: "van-2"})
+	assertEqual(t, summary.Unassigned[1], PlannerUnassigned{Kind: "job", Index: 1, ID: "j2"})
+}
@@ -2,6 +2,7 @@ package geoapify
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
@@ -89,8 +90,7 @@ func (r *RoutingRequest) WithFormat(f Format) *RoutingRequest {
 	return r
 }
 
-// Do executes the routing request.
-func (r *RoutingRequest) Do(ctx context.Context) (*RoutingResponse, error) {
+func (r *RoutingRequest) params() (url.Values, error) {
 	params := url.Values{}
 
 	// Build waypoints param: pipe-separated lat,lon pairs.
@@ -131,28 +131,192 @@ func (r *RoutingRequest) Do(ctx context.Context) (*RoutingResponse, error) {
 	if r.format != "" {
 		params.Set("format", string(r.format))
 	}
+	return params, nil
+}
+
+// Do executes the routing request.
+func (r *RoutingRequest) Do(ctx context.Context) (*RoutingResponse, error) {
+	params, err := r.params()
+	if err != nil {
+		return nil, err
+	}
 
 	var result RoutingResponse
 	if err := r.service.client.doGet(ctx, "/v1/routing", params, &result); err != nil {
 		return nil, err
 	}
+
+	r.restoreWaypoints(&result)
 	return &result, nil
 }
 
+// restoreWaypoints fills in the original input location of each waypoint,
+// since the API only echoes its index.
+func (r *RoutingRequest) restoreWaypoints(result *RoutingResponse) {
+	for i := range result.Results {
+		for j, wp := range result.Results[i].Waypoints {
+			if wp.OriginalIndex >= 0 && wp.OriginalIndex < len(r.waypoints) {
+				result.Results[i].Waypoints[j].OriginalLocation = r.waypoints[wp.OriginalIndex]
+			}
+		}
+	}
+}
+
 // RoutingResponse is the response from the routing API.
+// Both the JSON and GeoJSON response formats are decoded into Results.
 type RoutingResponse struct {
 	Results    []Route        `json:"results"`
 	Properties map[string]any `json:"properties,omitempty"`
 }
 
+// UnmarshalJSON implements custom unmarshalling for RoutingResponse.
+// A GeoJSON FeatureCollection is converted into Results, taking the route
+// from each feature's properties and the polyline from its geometry.
+func (r *RoutingResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Results    []Route          `json:"results"`
+		Features   []routingFeature `json:"features"`
+		Properties map[string]any   `json:"properties,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	r.Results = raw.Results
+	r.Properties = raw.Properties
+	for _, f := range raw.Features {
+		route := f.Properties
+		geometry, err := decodeRouteGeometry(f.Geometry)
+		if err != nil {
+			return err
+		}
+		route.Geometry = geometry
+		r.Results = append(r.Results, route)
+	}
+	for i := range r.Results {
+		attachLegGeometry(r.Results[i].Legs, r.Results[i].Geometry)
+	}
+	return nil
+}
+
+type routingFeature struct {
+	Properties Route         `json:"properties"`
+	Geometry   routeGeometry `json:"geometry"`
+}
+
+type routeGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// decodeRouteGeometry converts a LineString or MultiLineString geometry into
+// one polyline per leg.
+func decodeRouteGeometry(g routeGeometry) ([][]Location, error) {
+	switch g.Type {
+	case "LineString":
+		var coords [][]float64
+		if err := json.Unmarshal(g.Coordinates, &coords); err != nil {
+			return nil, fmt.Errorf("decoding route geometry: %w", err)
+		}
+		return [][]Location{toLocations(coords)}, nil
+	case "MultiLineString":
+		var coords [][][]float64
+		if err := json.Unmarshal(g.Coordinates, &coords); err != nil {
+			return nil, fmt.Errorf("decoding route geometry: %w", err)
+		}
+		out := make([][]Location, len(coords))
+		for i, line := range coords {
+			out[i] = toLocations(line)
+		}
+		return out, nil
+	}
+	return nil, nil
+}
+
+// toLocations converts GeoJSON [lon, lat] positions into Locations.
+func toLocations(coords [][]float64) []Location {
+	out := make([]Location, 0, len(coords))
+	for _, c := range coords {
+		if len(c) < 2 {
+			continue
+		}
+		out = append(out, LonLat(c[0], c[1]))
+	}
+	return out
+}
+
 // Route represents a single route result.
 type Route struct {
-	Distance      float64    `json:"distance"`
-	DistanceUnits string     `json:"distance_units,omitempty"`
-	Time          float64    `json:"time"`
-	Toll          bool       `json:"toll,omitempty"`
-	Ferry         bool       `json:"ferry,omitempty"`
-	Legs          []RouteLeg `json:"legs"`
+	Distance      float64         `json:"distance"`
+	DistanceUnits string          `json:"distance_units,omitempty"`
+	Time          float64         `json:"time"`
+	Toll          bool            `json:"toll,omitempty"`
+	Ferry         bool            `json:"ferry,omitempty"`
+	Legs          []RouteLeg      `json:"legs"`
+	Waypoints     []RouteWaypoint `json:"waypoints,omitempty"`
+	// Geometry holds one polyline per leg.
+	Geometry [][]Location `json:"geometry,omitempty"`
+}
+
+// attachLegGeometry copies each leg's polyline onto the leg so that step
+// indices can be resolved without the parent route.
+func attachLegGeometry(legs []RouteLeg, geometry [][]Location) {
+	if len(geometry) != len(legs) {
+		return
+	}
+	for i := range legs {
+		legs[i].Geometry = geometry[i]
+	}
+}
+
+// Polyline returns the full route geometry as a single polyline, joining the
+// legs and dropping the duplicated point where consecutive legs meet.
+func (r *Route) Polyline() []Location {
+	var out []Location
+	for _, leg := range r.Geometry {
+		if len(out) > 0 && len(leg) > 0 && out[len(out)-1] == leg[0] {
+			leg = leg[1:]
+		}
+		out = append(out, leg...)
+	}
+	return out
+}
+
+// RouteWaypoint represents an input waypoint snapped to the road network.
+type RouteWaypoint struct {
+	// Location is the snapped location on the road network.
+	Location Location
+	// OriginalLocation is the waypoint as it was passed to the request.
+	OriginalLocation Location
+	// OriginalIndex is the position of the waypoint in the request.
+	OriginalIndex int
+}
+
+// UnmarshalJSON implements custom unmarshalling for RouteWaypoint.
+func (w *RouteWaypoint) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Location      []float64 `json:"location"`
+		OriginalIndex int       `json:"original_index"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw.Location) >= 2 {
+		w.Location = LonLat(raw.Location[0], raw.Location[1])
+	}
+	w.OriginalIndex = raw.OriginalIndex
+	return nil
+}
+
+// MarshalJSON implements custom marshalling for RouteWaypoint.
+func (w RouteWaypoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Location      [2]float64 `json:"location"`
+		OriginalIndex int        `json:"original_index"`
+	}{
+		Location:      [2]float64{w.Location.Lon, w.Location.Lat},
+		OriginalIndex: w.OriginalIndex,
+	})
 }
 
 // RouteLeg represents a leg of a route.
@@ -163,6 +327,22 @@ type RouteLeg struct {
 	Elevation      []float64   `json:"elevation,omitempty"`
 	ElevationRange [][]float64 `json:"elevation_range,omitempty"`
 	CountryCode    []string    `json:"country_code,omitempty"`
+	// Geometry is the leg polyline, populated when the route geometry
+	// was returned. LegStep indices point into it.
+	Geometry []Location `json:"-"`
+}
+
+// StepGeometry returns the polyline covered by the step at index i, or nil
+// if the leg has no geometry or the step indices are out of range.
+func (l *RouteLeg) StepGeometry(i int) []Location {
+	if i < 0 || i >= len(l.Steps) {
+		return nil
+	}
+	s := l.Steps[i]
+	if s.FromIndex < 0 || s.ToIndex < s.FromIndex || s.ToIndex >= len(l.Geometry) {
+		return nil
+	}
+	return l.Geometry[s.FromIndex : s.ToIndex+1]
 }
 
 // LegStep represents a step within a route leg.
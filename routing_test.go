@@ -152,3 +152,83 @@ func TestRouting_DefaultsOmitted(t *testing.T) {
 		Do(context.Background())
 	assertNoError(t, err)
 }
+
+func TestRouting_JSONGeometryAndWaypoints(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"results":[{
+			"distance":300,"time":60,
+			"waypoints":[
+				{"location":[4.5691,50.6791],"original_index":0},
+				{"location":[4.5781,50.6611],"original_index":1}
+			],
+			"legs":[{"distance":300,"time":60,"steps":[
+				{"distance":100,"time":20,"from_index":0,"to_index":1},
+				{"distance":200,"time":40,"from_index":1,"to_index":2}
+			]}],
+			"geometry":[[{"lat":50.6791,"lon":4.5691},{"lat":50.67,"lon":4.57},{"lat":50.6611,"lon":4.5781}]]
+		}]}`))
+	})
+
+	got, err := client.Routing().
+		Waypoints(LatLon(50.679, 4.569), LatLon(50.661, 4.578)).
+		WithFormat(FormatJSON).
+		Do(context.Background())
+	assertNoError(t, err)
+
+	route := got.Results[0]
+	assertEqual(t, len(route.Waypoints), 2)
+	assertEqual(t, route.Waypoints[0].Location, LatLon(50.6791, 4.5691))
+	assertEqual(t, route.Waypoints[0].OriginalLocation, LatLon(50.679, 4.569))
+	assertEqual(t, route.Waypoints[1].OriginalIndex, 1)
+	assertEqual(t, route.Waypoints[1].OriginalLocation, LatLon(50.661, 4.578))
+
+	assertEqual(t, len(route.Geometry), 1)
+	assertEqual(t, len(route.Geometry[0]), 3)
+	assertEqual(t, len(route.Legs[0].Geometry), 3)
+
+	step := route.Legs[0].StepGeometry(1)
+	assertEqual(t, len(step), 2)
+	assertEqual(t, step[0], LatLon(50.67, 4.57))
+	assertEqual(t, step[1], LatLon(50.6611, 4.5781))
+	assertEqual(t, len(route.Legs[0].StepGeometry(5)), 0)
+}
+
+func TestRouting_GeoJSONFormat(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"type":"FeatureCollection","features":[{
+			"type":"Feature",
+			"properties":{
+				"distance":500,"time":90,
+				"waypoints":[
+					{"location":[1,1],"original_index":0},
+					{"location":[2,2],"original_index":1},
+					{"location":[3,3],"original_index":2}
+				],
+				"legs":[
+					{"distance":250,"time":45,"steps":[{"from_index":0,"to_index":1}]},
+					{"distance":250,"time":45,"steps":[{"from_index":0,"to_index":1}]}
+				]
+			},
+			"geometry":{"type":"MultiLineString","coordinates":[[[1,1],[2,2]],[[2,2],[3,3]]]}
+		}],"properties":{"mode":"drive"}}`))
+	})
+
+	got, err := client.Routing().
+		Waypoints(LatLon(1, 1), LatLon(2, 2), LatLon(3, 3)).
+		Do(context.Background())
+	assertNoError(t, err)
+
+	assertEqual(t, len(got.Results), 1)
+	route := got.Results[0]
+	assertEqual(t, route.Distance, 500.0)
+	assertEqual(t, len(route.Legs), 2)
+	assertEqual(t, len(route.Waypoints), 3)
+	assertEqual(t, route.Waypoints[2].Location, LonLat(3, 3))
+	assertEqual(t, route.Legs[1].StepGeometry(0)[1], LonLat(3, 3))
+	assertEqual(t, got.Properties["mode"], any("drive"))
+
+	line := route.Polyline()
+	assertEqual(t, len(line), 3)
+	assertEqual(t, line[0], LonLat(1, 1))
+	assertEqual(t, line[2], LonLat(3, 3))
+}
@@ -1,5 +0,0 @@
-package geoapify
-
-// Service stubs for APIs not yet implemented.
-
-
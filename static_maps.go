@@ -0,0 +1,448 @@
+package geoapify
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// StaticMapsService provides access to the GeoApify Static Maps API.
+type StaticMapsService struct {
+	client *Client
+}
+
+// Map creates a new static map request builder.
+func (s *StaticMapsService) Map() *StaticMapRequest {
+	return &StaticMapRequest{service: s}
+}
+
+// StaticMapRequest is a builder for static map API requests.
+type StaticMapRequest struct {
+	service     *StaticMapsService
+	style       MapStyle
+	width       int
+	height      int
+	scaleFactor int
+	center      *Location
+	zoom        float64
+	area        *BBox
+	markers     []StaticMapMarker
+	geometries  []StaticMapGeometry
+	format      ImageFormat
+}
+
+// WithStyle sets the map style.
+func (r *StaticMapRequest) WithStyle(s MapStyle) *StaticMapRequest {
+	r.style = s
+	return r
+}
+
+// WithSize sets the image size in pixels.
+func (r *StaticMapRequest) WithSize(width, height int) *StaticMapRequest {
+	r.width = width
+	r.height = height
+	return r
+}
+
+// WithScaleFactor sets the pixel density, e.g. 2 for high-DPI screens.
+func (r *StaticMapRequest) WithScaleFactor(n int) *StaticMapRequest {
+	r.scaleFactor = n
+	return r
+}
+
+// WithCenter centers the map on a location at the given zoom level.
+func (r *StaticMapRequest) WithCenter(loc Location, zoom float64) *StaticMapRequest {
+	r.center = &loc
+	r.zoom = zoom
+	return r
+}
+
+// WithArea fits the map to a bounding box.
+func (r *StaticMapRequest) WithArea(b BBox) *StaticMapRequest {
+	r.area = &b
+	return r
+}
+
+// WithMarkers adds markers to the map.
+func (r *StaticMapRequest) WithMarkers(markers ...StaticMapMarker) *StaticMapRequest {
+	r.markers = append(r.markers, markers...)
+	return r
+}
+
+// WithGeometries adds geometry overlays to the map. Lines and polygons too
+// long to fit in the map URL are simplified until they do.
+func (r *StaticMapRequest) WithGeometries(geometries ...StaticMapGeometry) *StaticMapRequest {
+	r.geometries = append(r.geometries, geometries...)
+	return r
+}
+
+// WithFormat sets the image format.
+func (r *StaticMapRequest) WithFormat(f ImageFormat) *StaticMapRequest {
+	r.format = f
+	return r
+}
+
+func (r *StaticMapRequest) params() (url.Values, error) {
+	params := url.Values{}
+
+	if r.style != "" {
+		params.Set("style", string(r.style))
+	}
+	if r.width < 0 || r.height < 0 {
+		return nil, fmt.Errorf("%w: negative map size", ErrInvalidArgument)
+	}
+	if r.width > 0 {
+		params.Set("width", strconv.Itoa(r.width))
+	}
+	if r.height > 0 {
+		params.Set("height", strconv.Itoa(r.height))
+	}
+	if r.scaleFactor > 0 {
+		params.Set("scaleFactor", strconv.Itoa(r.scaleFactor))
+	}
+	if r.center != nil {
+		if err := validateLonLat(r.center.Lon, r.center.Lat); err != nil {
+			return nil, err
+		}
+		params.Set("center", "lonlat:"+formatLonLat(*r.center))
+		params.Set("zoom", formatCoord(r.zoom))
+	}
+	if r.area != nil {
+		if err := r.area.Filter().Err(); err != nil {
+			return nil, err
+		}
+		params.Set("area", fmt.Sprintf("rect:%s,%s,%s,%s",
+			formatCoord(r.area.MinLon), formatCoord(r.area.MinLat),
+			formatCoord(r.area.MaxLon), formatCoord(r.area.MaxLat)))
+	}
+	if len(r.markers) > 0 {
+		parts := make([]string, len(r.markers))
+		for i, m := range r.markers {
+			v, err := m.encode()
+			if err != nil {
+				return nil, err
+			}
+			parts[i] = v
+		}
+		params.Set("marker", strings.Join(parts, "|"))
+	}
+	if r.format != "" {
+		params.Set("format", string(r.format))
+	}
+	if len(r.geometries) > 0 {
+		v, err := r.encodeGeometries(0)
+		if err != nil {
+			return nil, err
+		}
+		params.Set("geometry", v)
+		// Long geometries, such as a route across a country, would exceed
+		// the URL length servers accept. Simplify them with a growing
+		// tolerance until the query fits.
+		for tolerance := 1.0; len(params.Encode()) > maxStaticMapQueryLength; tolerance *= 2 {
+			if tolerance > maxStaticMapTolerance {
+				return nil, fmt.Errorf("%w: map geometries are too long for a static map URL", ErrInvalidArgument)
+			}
+			if v, err = r.encodeGeometries(tolerance); err != nil {
+				return nil, err
+			}
+			params.Set("geometry", v)
+		}
+	}
+	return params, nil
+}
+
+// Limits for fitting geometries into the map URL: the query length, well
+// below the 8 KB most servers accept, and the largest simplification
+// tolerance in meters tried before giving up.
+const (
+	maxStaticMapQueryLength = 7000
+	maxStaticMapTolerance   = 100_000
+)
+
+// encodeGeometries encodes the geometry parameter, simplifying lines and
+// polygons with the given tolerance in meters.
+func (r *StaticMapRequest) encodeGeometries(tolerance float64) (string, error) {
+	parts := make([]string, len(r.geometries))
+	for i, g := range r.geometries {
+		v, err := g.encode(tolerance)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = v
+	}
+	return strings.Join(parts, "|"), nil
+}
+
+// URL returns the map URL, including the API key, for embedding in web pages
+// or emails.
+func (r *StaticMapRequest) URL() (string, error) {
+	params, err := r.params()
+	if err != nil {
+		return "", err
+	}
+	c := r.service.client
+	return c.buildURLWithBase(c.mapsBaseURL, "/v1/staticmap", params), nil
+}
+
+// Do renders the map and returns the image bytes.
+func (r *StaticMapRequest) Do(ctx context.Context) ([]byte, error) {
+	u, err := r.URL()
+	if err != nil {
+		return nil, err
+	}
+	return r.service.client.doGetBytes(ctx, u)
+}
+
+// MarkerType represents the shape of a map marker.
+type MarkerType string
+
+const (
+	MarkerMaterial MarkerType = "material"
+	MarkerAwesome  MarkerType = "awesome"
+	MarkerCircle   MarkerType = "circle"
+	MarkerPlain    MarkerType = "plain"
+)
+
+// MarkerSize represents the size of a map marker.
+type MarkerSize string
+
+const (
+	MarkerSmall   MarkerSize = "small"
+	MarkerMedium  MarkerSize = "medium"
+	MarkerLarge   MarkerSize = "large"
+	MarkerXLarge  MarkerSize = "x-large"
+	MarkerXXLarge MarkerSize = "xx-large"
+)
+
+// IconType represents the icon set used inside a marker.
+type IconType string
+
+const (
+	IconMaterial IconType = "material"
+	IconAwesome  IconType = "awesome"
+	IconLucide   IconType = "lucide"
+)
+
+// StaticMapMarker is a marker drawn on a static map. Zero values are
+// omitted and left to the API defaults.
+type StaticMapMarker struct {
+	Location Location
+	Type     MarkerType
+	// Color is a CSS color, e.g. "#ff0000" or "red".
+	Color string
+	Size  MarkerSize
+	// Icon is the name of an icon from IconType's icon set.
+	Icon     string
+	IconType IconType
+	// Text is drawn instead of an icon.
+	Text         string
+	TextSize     MarkerSize
+	ContentColor string
+	StrokeColor  string
+	Shadow       *bool
+	ShadowColor  string
+	WhiteCircle  *bool
+}
+
+func (m StaticMapMarker) encode() (string, error) {
+	if err := validateLonLat(m.Location.Lon, m.Location.Lat); err != nil {
+		return "", err
+	}
+	parts := []string{"lonlat:" + formatLonLat(m.Location)}
+	var err error
+	add := func(key, value string) {
+		if value == "" || err != nil {
+			return
+		}
+		if err = checkStaticMapValue(key, value); err == nil {
+			parts = append(parts, key+":"+value)
+		}
+	}
+	add("type", string(m.Type))
+	add("color", m.Color)
+	add("size", string(m.Size))
+	add("icon", m.Icon)
+	add("icontype", string(m.IconType))
+	add("text", m.Text)
+	add("textsize", string(m.TextSize))
+	add("contentcolor", m.ContentColor)
+	add("strokecolor", m.StrokeColor)
+	add("shadow", yesNo(m.Shadow))
+	add("shadowcolor", m.ShadowColor)
+	add("whitecircle", yesNo(m.WhiteCircle))
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(parts, ";"), nil
+}
+
+// checkStaticMapValue rejects values containing the separators of the
+// marker and geometry parameters, which the API offers no way to escape.
+func checkStaticMapValue(key, value string) error {
+	if strings.ContainsAny(value, ";|:") {
+		return fmt.Errorf("%w: %s %q must not contain ';', '|' or ':'", ErrInvalidArgument, key, value)
+	}
+	return nil
+}
+
+func yesNo(b *bool) string {
+	switch {
+	case b == nil:
+		return ""
+	case *b:
+		return "yes"
+	default:
+		return "no"
+	}
+}
+
+// LineStyle represents the dash style of a line.
+type LineStyle string
+
+const (
+	LineSolid       LineStyle = "solid"
+	LineDotted      LineStyle = "dotted"
+	LineDashed      LineStyle = "dashed"
+	LineLongDash    LineStyle = "longdash"
+	LineDashDot     LineStyle = "dashdot"
+	LineLongDashDot LineStyle = "longdashdot"
+)
+
+// OverlayStyle controls how a geometry overlay is drawn. Zero values are
+// omitted and left to the API defaults.
+type OverlayStyle struct {
+	LineColor   string
+	LineWidth   int
+	LineOpacity float64
+	LineStyle   LineStyle
+	FillColor   string
+	FillOpacity float64
+}
+
+func (s OverlayStyle) encode() ([]string, error) {
+	if err := checkStaticMapValue("linecolor", s.LineColor); err != nil {
+		return nil, err
+	}
+	if err := checkStaticMapValue("fillcolor", s.FillColor); err != nil {
+		return nil, err
+	}
+	if err := checkStaticMapValue("linestyle", string(s.LineStyle)); err != nil {
+		return nil, err
+	}
+	var parts []string
+	if s.LineColor != "" {
+		parts = append(parts, "linecolor:"+s.LineColor)
+	}
+	if s.LineWidth > 0 {
+		parts = append(parts, "linewidth:"+strconv.Itoa(s.LineWidth))
+	}
+	if s.LineOpacity > 0 {
+		parts = append(parts, "lineopacity:"+formatCoord(s.LineOpacity))
+	}
+	if s.LineStyle != "" {
+		parts = append(parts, "linestyle:"+string(s.LineStyle))
+	}
+	if s.FillColor != "" {
+		parts = append(parts, "fillcolor:"+s.FillColor)
+	}
+	if s.FillOpacity > 0 {
+		parts = append(parts, "fillopacity:"+formatCoord(s.FillOpacity))
+	}
+	return parts, nil
+}
+
+// StaticMapGeometry is a geometry overlay drawn on a static map.
+type StaticMapGeometry struct {
+	kind   string
+	points []Location
+	radius int
+	Style  OverlayStyle
+}
+
+// PolylineOverlay creates a polyline overlay.
+func PolylineOverlay(points []Location, style OverlayStyle) StaticMapGeometry {
+	return StaticMapGeometry{kind: "polyline", points: points, Style: style}
+}
+
+// PolygonOverlay creates a polygon overlay from an outer ring.
+func PolygonOverlay(ring []Location, style OverlayStyle) StaticMapGeometry {
+	return StaticMapGeometry{kind: "polygon", points: ring, Style: style}
+}
+
+// CircleOverlay creates a circle overlay with a radius in pixels.
+func CircleOverlay(center Location, radiusPixels int, style OverlayStyle) StaticMapGeometry {
+	return StaticMapGeometry{kind: "circle", points: []Location{center}, radius: radiusPixels, Style: style}
+}
+
+// RouteOverlays creates one polyline overlay per leg of a route.
+func RouteOverlays(route *Route, style OverlayStyle) []StaticMapGeometry {
+	out := make([]StaticMapGeometry, 0, len(route.Geometry))
+	for _, leg := range route.Geometry {
+		out = append(out, PolylineOverlay(leg, style))
+	}
+	return out
+}
+
+// IsolineOverlays creates one polygon overlay per polygon of an isoline.
+// Holes are not drawn.
+func IsolineOverlays(iso *Isoline, style OverlayStyle) []StaticMapGeometry {
+	out := make([]StaticMapGeometry, 0, len(iso.Geometry))
+	for _, p := range iso.Geometry {
+		if len(p) > 0 {
+			out = append(out, PolygonOverlay(p[0], style))
+		}
+	}
+	return out
+}
+
+func (g StaticMapGeometry) encode(tolerance float64) (string, error) {
+	if len(g.points) == 0 {
+		return "", fmt.Errorf("%w: %s overlay has no points", ErrInvalidArgument, g.kind)
+	}
+	points := g.points
+	switch g.kind {
+	case "polyline":
+		points = simplifyLine(points, tolerance, SimplifyDouglasPeucker)
+	case "polygon":
+		if s := simplifyLine(points, tolerance, SimplifyDouglasPeucker); len(s) >= 4 {
+			points = s
+		}
+	}
+	coords := make([]string, len(points))
+	for i, p := range points {
+		if err := validateLonLat(p.Lon, p.Lat); err != nil {
+			return "", err
+		}
+		coords[i] = formatLonLat(p)
+	}
+	value := strings.Join(coords, ",")
+	if g.kind == "circle" {
+		if g.radius <= 0 {
+			return "", fmt.Errorf("%w: circle radius must be positive", ErrInvalidArgument)
+		}
+		value += "," + strconv.Itoa(g.radius)
+	}
+	style, err := g.Style.encode()
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(append([]string{g.kind + ":" + value}, style...), ";"), nil
+}
+
+// formatCoord formats a number with at most six decimals, which is about
+// 10cm for coordinates, and no trailing zeros.
+func formatCoord(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 6, 64)
+	s = strings.TrimRight(s, "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+func formatLonLat(l Location) string {
+	return formatCoord(l.Lon) + "," + formatCoord(l.Lat)
+}
@@ -0,0 +1,166 @@
+package geoapify
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestStaticMaps_Params(t *testing.T) {
+	no := false
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assertEqual(t, r.URL.Path, "/v1/staticmap")
+		q := r.URL.Query()
+		assertEqual(t, q.Get("apiKey"), "test-api-key")
+		assertEqual(t, q.Get("style"), "osm-bright")
+		assertEqual(t, q.Get("width"), "600")
+		assertEqual(t, q.Get("height"), "400")
+		assertEqual(t, q.Get("scaleFactor"), "2")
+		assertEqual(t, q.Get("center"), "lonlat:-122.29,47.54")
+		assertEqual(t, q.Get("zoom"), "14.5")
+		assertEqual(t, q.Get("format"), "jpeg")
+		assertEqual(t, q.Get("marker"),
+			"lonlat:-122.29,47.54;type:awesome;color:#ff0000;size:large;icon:store;icontype:awesome;shadow:no"+
+				"|lonlat:-122.3,47.55;text:2")
+		assertEqual(t, q.Get("geometry"),
+			"polyline:-122.29,47.54,-122.3,47.55;linecolor:#0000ff;linewidth:4;linestyle:dashed"+
+				"|circle:-122.29,47.54,20;fillcolor:#00ff00;fillopacity:0.3")
+		w.Header().Set("Content-Type", "image/jpeg")
+		w.Write([]byte("\xff\xd8jpeg"))
+	})
+
+	img, err := client.StaticMaps().Map().
+		WithStyle(StyleOSMBright).
+		WithSize(600, 400).
+		WithScaleFactor(2).
+		WithCenter(LatLon(47.54, -122.29), 14.5).
+		WithFormat(ImageJPEG).
+		WithMarkers(
+			StaticMapMarker{
+				Location: LatLon(47.54, -122.29),
+				Type:     MarkerAwesome,
+				Color:    "#ff0000",
+				Size:     MarkerLarge,
+				Icon:     "store",
+				IconType: IconAwesome,
+				Shadow:   &no,
+			},
+			StaticMapMarker{Location: LatLon(47.55, -122.3), Text: "2"},
+		).
+		WithGeometries(
+			PolylineOverlay([]Location{LatLon(47.54, -122.29), LatLon(47.55, -122.3)},
+				OverlayStyle{LineColor: "#0000ff", LineWidth: 4, LineStyle: LineDashed}),
+			CircleOverlay(LatLon(47.54, -122.29), 20, OverlayStyle{FillColor: "#00ff00", FillOpacity: 0.3}),
+		).
+		Do(context.Background())
+	assertNoError(t, err)
+	assertEqual(t, string(img), "\xff\xd8jpeg")
+}
+
+func TestStaticMaps_URL(t *testing.T) {
+	client := NewClient("my-key")
+	u, err := client.StaticMaps().Map().
+		WithArea(BBox{MinLon: 2.2, MinLat: 48.8, MaxLon: 2.4, MaxLat: 48.9}).
+		URL()
+	assertNoError(t, err)
+	if !strings.HasPrefix(u, "https://maps.geoapify.com/v1/staticmap?") {
+		t.Fatalf("unexpected URL %s", u)
+	}
+	parsed, err := url.Parse(u)
+	assertNoError(t, err)
+	assertEqual(t, parsed.Query().Get("apiKey"), "my-key")
+	assertEqual(t, parsed.Query().Get("area"), "rect:2.2,48.8,2.4,48.9")
+}
+
+func TestStaticMaps_OverlaysFromResults(t *testing.T) {
+	route := &Route{Geometry: [][]Location{
+		{LonLat(1, 1), LonLat(2, 2)},
+		{LonLat(2, 2), LonLat(3, 3)},
+	}}
+	iso := &Isoline{Geometry: MultiPolygon{{square(0, 0, 1, 1), square(0.2, 0.2, 0.4, 0.4)}}}
+
+	req := NewClient("key").StaticMaps().Map().
+		WithGeometries(RouteOverlays(route, OverlayStyle{LineColor: "red"})...).
+		WithGeometries(IsolineOverlays(iso, OverlayStyle{FillColor: "blue"})...)
+	u, err := req.URL()
+	assertNoError(t, err)
+
+	parsed, err := url.Parse(u)
+	assertNoError(t, err)
+	assertEqual(t, parsed.Query().Get("geometry"),
+		"polyline:1,1,2,2;linecolor:red|polyline:2,2,3,3;linecolor:red|polygon:0,0,1,0,1,1,0,1,0,0;fillcolor:blue")
+}
+
+func TestStaticMaps_LongRoute(t *testing.T) {
+	// A 5000-point route wiggling across 5 degrees of longitude, far too
+	// long for a URL when encoded point by point.
+	leg := make([]Location, 5000)
+	for i := range leg {
+		leg[i] = LonLat(2+float64(i)*0.001, 48+0.01*math.Sin(float64(i)/50))
+	}
+	route := &Route{Geometry: [][]Location{leg}}
+
+	var got *http.Request
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.Write([]byte("png"))
+	})
+	_, err := client.StaticMaps().Map().
+		WithGeometries(RouteOverlays(route, OverlayStyle{LineColor: "red"})...).
+		Do(context.Background())
+	assertNoError(t, err)
+
+	if n := len(got.URL.RawQuery); n > maxStaticMapQueryLength+100 {
+		t.Fatalf("query is %d bytes long", n)
+	}
+	geometry := got.URL.Query().Get("geometry")
+	assertEqual(t, strings.HasPrefix(geometry, "polyline:2,48,"), true)
+	assertEqual(t, strings.HasSuffix(geometry, formatLonLat(leg[len(leg)-1])+";linecolor:red"), true)
+	if points := strings.Count(geometry, ",") / 2; points < 20 {
+		t.Fatalf("route simplified to %d points", points)
+	}
+}
+
+func TestStaticMaps_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *StaticMapRequest
+	}{
+		{"bad center", NewClient("k").StaticMaps().Map().WithCenter(LatLon(100, 0), 3)},
+		{"bad marker", NewClient("k").StaticMaps().Map().WithMarkers(StaticMapMarker{Location: LonLat(200, 0)})},
+		{"empty overlay", NewClient("k").StaticMaps().Map().WithGeometries(PolylineOverlay(nil, OverlayStyle{}))},
+		{"zero circle", NewClient("k").StaticMaps().Map().WithGeometries(CircleOverlay(LonLat(0, 0), 0, OverlayStyle{}))},
+		{"negative size", NewClient("k").StaticMaps().Map().WithSize(-1, 100)},
+		{"marker text with separator", NewClient("k").StaticMaps().Map().WithMarkers(StaticMapMarker{Location: LonLat(0, 0), Text: "A;B"})},
+		{"marker text with pipe", NewClient("k").StaticMaps().Map().WithMarkers(StaticMapMarker{Location: LonLat(0, 0), Text: "1|2"})},
+		{"marker icon with colon", NewClient("k").StaticMaps().Map().WithMarkers(StaticMapMarker{Location: LonLat(0, 0), Icon: "a:b"})},
+		{"marker color with separator", NewClient("k").StaticMaps().Map().WithMarkers(StaticMapMarker{Location: LonLat(0, 0), Color: "#fff;size:large"})},
+		{"overlay color with pipe", NewClient("k").StaticMaps().Map().WithGeometries(PolylineOverlay([]Location{LonLat(0, 0), LonLat(1, 1)}, OverlayStyle{LineColor: "red|polyline"}))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.req.URL()
+			if !errors.Is(err, ErrInvalidArgument) {
+				t.Fatalf("expected ErrInvalidArgument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestStaticMaps_APIError(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message":"Invalid apiKey"}`))
+	})
+
+	_, err := client.StaticMaps().Map().WithSize(100, 100).Do(context.Background())
+	apiErr, ok := IsAPIError(err)
+	if !ok {
+		t.Fatal("expected APIError")
+	}
+	assertEqual(t, apiErr.StatusCode, 401)
+}
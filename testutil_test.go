@@ -13,7 +13,7 @@ func newTestServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *C
 	t.Helper()
 	server := httptest.NewServer(handler)
 	t.Cleanup(server.Close)
-	client := NewClient("test-api-key", WithBaseURL(server.URL))
+	client := NewClient("test-api-key", WithBaseURL(server.URL), WithMapsBaseURL(server.URL))
 	return server, client
 }
 
@@ -0,0 +1,149 @@
+package geoapify
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// ErrTileNotFound is returned by a TileStore when a tile is not stored.
+var ErrTileNotFound = errors.New("geoapify: tile not found")
+
+// TileStore persists downloaded tiles. Implementations must be safe for
+// concurrent use.
+type TileStore interface {
+	HasTile(ctx context.Context, t Tile) (bool, error)
+	GetTile(ctx context.Context, t Tile) ([]byte, error)
+	PutTile(ctx context.Context, t Tile, data []byte) error
+}
+
+// DirTileStore stores tiles as files laid out as {root}/{z}/{x}/{y}.png.
+type DirTileStore struct {
+	root string
+}
+
+// NewDirTileStore returns a store rooted at the given directory. The directory
+// is created on first write.
+func NewDirTileStore(root string) *DirTileStore {
+	return &DirTileStore{root: root}
+}
+
+func (s *DirTileStore) path(t Tile) string {
+	return filepath.Join(s.root, strconv.Itoa(t.Z), strconv.Itoa(t.X), strconv.Itoa(t.Y)+".png")
+}
+
+// HasTile reports whether the tile file exists.
+func (s *DirTileStore) HasTile(_ context.Context, t Tile) (bool, error) {
+	_, err := os.Stat(s.path(t))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// GetTile reads the tile file.
+func (s *DirTileStore) GetTile(_ context.Context, t Tile) ([]byte, error) {
+	data, err := os.ReadFile(s.path(t))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrTileNotFound
+	}
+	return data, err
+}
+
+// PutTile writes the tile file. The data is written to a temporary file first
+// so an interrupted write never leaves a partial tile behind.
+func (s *DirTileStore) PutTile(_ context.Context, t Tile, data []byte) error {
+	path := s.path(t)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), ".tile-*")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// MBTilesStore stores tiles in an MBTiles database. The library does not
+// bundle a SQLite driver; open the database with the driver of your choice
+// and pass it to NewMBTilesStore.
+type MBTilesStore struct {
+	db *sql.DB
+}
+
+// NewMBTilesStore creates the MBTiles schema in db if it does not exist and
+// returns a store backed by it.
+func NewMBTilesStore(ctx context.Context, db *sql.DB) (*MBTilesStore, error) {
+	stmts := []string{
+		"CREATE TABLE IF NOT EXISTS metadata (name TEXT, value TEXT)",
+		"CREATE TABLE IF NOT EXISTS tiles (zoom_level INTEGER, tile_column INTEGER, tile_row INTEGER, tile_data BLOB)",
+		"CREATE UNIQUE INDEX IF NOT EXISTS tile_index ON tiles (zoom_level, tile_column, tile_row)",
+	}
+	for _, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("creating mbtiles schema: %w", err)
+		}
+	}
+	return &MBTilesStore{db: db}, nil
+}
+
+// SetMetadata sets a value in the MBTiles metadata table, such as "name",
+// "format" or "bounds".
+func (s *MBTilesStore) SetMetadata(ctx context.Context, name, value string) error {
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM metadata WHERE name = ?", name); err != nil {
+		return err
+	}
+	_, err := s.db.ExecContext(ctx, "INSERT INTO metadata (name, value) VALUES (?, ?)", name, value)
+	return err
+}
+
+// tmsRow converts an XYZ row to the flipped TMS row MBTiles uses.
+func tmsRow(t Tile) int {
+	return (1 << t.Z) - 1 - t.Y
+}
+
+// HasTile reports whether the tile is in the database.
+func (s *MBTilesStore) HasTile(ctx context.Context, t Tile) (bool, error) {
+	var one int
+	err := s.db.QueryRowContext(ctx,
+		"SELECT 1 FROM tiles WHERE zoom_level = ? AND tile_column = ? AND tile_row = ?",
+		t.Z, t.X, tmsRow(t)).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// GetTile reads the tile from the database.
+func (s *MBTilesStore) GetTile(ctx context.Context, t Tile) ([]byte, error) {
+	var data []byte
+	err := s.db.QueryRowContext(ctx,
+		"SELECT tile_data FROM tiles WHERE zoom_level = ? AND tile_column = ? AND tile_row = ?",
+		t.Z, t.X, tmsRow(t)).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTileNotFound
+	}
+	return data, err
+}
+
+// PutTile inserts or replaces the tile in the database.
+func (s *MBTilesStore) PutTile(ctx context.Context, t Tile, data []byte) error {
+	_, err := s.db.ExecContext(ctx,
+		"INSERT OR REPLACE INTO tiles (zoom_level, tile_column, tile_row, tile_data) VALUES (?, ?, ?, ?)",
+		t.Z, t.X, tmsRow(t), data)
+	return err
+}
@@ -0,0 +1,178 @@
+package geoapify
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestDirTileStore(t *testing.T) {
+	root := t.TempDir()
+	store := NewDirTileStore(root)
+	ctx := context.Background()
+	tile := Tile{Z: 3, X: 4, Y: 2}
+
+	ok, err := store.HasTile(ctx, tile)
+	assertNoError(t, err)
+	assertEqual(t, ok, false)
+
+	_, err = store.GetTile(ctx, tile)
+	if !errors.Is(err, ErrTileNotFound) {
+		t.Fatalf("expected ErrTileNotFound, got %v", err)
+	}
+
+	assertNoError(t, store.PutTile(ctx, tile, []byte("png")))
+	ok, err = store.HasTile(ctx, tile)
+	assertNoError(t, err)
+	assertEqual(t, ok, true)
+
+	data, err := store.GetTile(ctx, tile)
+	assertNoError(t, err)
+	assertEqual(t, string(data), "png")
+
+	_, err = os.Stat(filepath.Join(root, "3", "4", "2.png"))
+	assertNoError(t, err)
+
+	entries, err := os.ReadDir(filepath.Join(root, "3", "4"))
+	assertNoError(t, err)
+	assertEqual(t, len(entries), 1)
+}
+
+func TestTMSRow(t *testing.T) {
+	assertEqual(t, tmsRow(Tile{Z: 0, X: 0, Y: 0}), 0)
+	assertEqual(t, tmsRow(Tile{Z: 3, X: 4, Y: 2}), 5)
+}
+
+func TestMBTilesStore(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeMBTiles{tiles: map[[3]int64][]byte{}, metadata: map[string]string{}}
+	db := sql.OpenDB(fake)
+	defer db.Close()
+
+	store, err := NewMBTilesStore(ctx, db)
+	assertNoError(t, err)
+	assertEqual(t, fake.schema, 3)
+
+	tile := Tile{Z: 2, X: 1, Y: 0}
+	ok, err := store.HasTile(ctx, tile)
+	assertNoError(t, err)
+	assertEqual(t, ok, false)
+	_, err = store.GetTile(ctx, tile)
+	if !errors.Is(err, ErrTileNotFound) {
+		t.Fatalf("expected ErrTileNotFound, got %v", err)
+	}
+
+	assertNoError(t, store.PutTile(ctx, tile, []byte("png")))
+	ok, err = store.HasTile(ctx, tile)
+	assertNoError(t, err)
+	assertEqual(t, ok, true)
+	data, err := store.GetTile(ctx, tile)
+	assertNoError(t, err)
+	assertEqual(t, string(data), "png")
+
+	// Rows are stored flipped to the TMS scheme.
+	_, ok = fake.tiles[[3]int64{2, 1, 3}]
+	assertEqual(t, ok, true)
+
+	assertNoError(t, store.PutTile(ctx, tile, []byte("png2")))
+	data, err = store.GetTile(ctx, tile)
+	assertNoError(t, err)
+	assertEqual(t, string(data), "png2")
+
+	assertNoError(t, store.SetMetadata(ctx, "format", "png"))
+	assertNoError(t, store.SetMetadata(ctx, "format", "jpg"))
+	assertEqual(t, fake.metadata["format"], "jpg")
+}
+
+// fakeMBTiles is a database/sql driver understanding just the statements
+// issued by MBTilesStore.
+type fakeMBTiles struct {
+	mu       sync.Mutex
+	schema   int
+	tiles    map[[3]int64][]byte
+	metadata map[string]string
+}
+
+func (f *fakeMBTiles) Connect(context.Context) (driver.Conn, error) { return f, nil }
+func (f *fakeMBTiles) Driver() driver.Driver                        { return nil }
+func (f *fakeMBTiles) Close() error                                 { return nil }
+func (f *fakeMBTiles) Begin() (driver.Tx, error)                    { return nil, errors.New("not supported") }
+
+func (f *fakeMBTiles) Prepare(query string) (driver.Stmt, error) {
+	return &fakeMBTilesStmt{db: f, query: query}, nil
+}
+
+type fakeMBTilesStmt struct {
+	db    *fakeMBTiles
+	query string
+}
+
+func (s *fakeMBTilesStmt) Close() error  { return nil }
+func (s *fakeMBTilesStmt) NumInput() int { return -1 }
+
+func (s *fakeMBTilesStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	switch {
+	case strings.HasPrefix(s.query, "CREATE"):
+		s.db.schema++
+	case strings.HasPrefix(s.query, "DELETE FROM metadata"):
+		delete(s.db.metadata, args[0].(string))
+	case strings.HasPrefix(s.query, "INSERT INTO metadata"):
+		s.db.metadata[args[0].(string)] = args[1].(string)
+	case strings.HasPrefix(s.query, "INSERT OR REPLACE INTO tiles"):
+		key := [3]int64{args[0].(int64), args[1].(int64), args[2].(int64)}
+		s.db.tiles[key] = append([]byte(nil), args[3].([]byte)...)
+	default:
+		return nil, fmt.Errorf("unexpected statement %q", s.query)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeMBTilesStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	key := [3]int64{args[0].(int64), args[1].(int64), args[2].(int64)}
+	data, ok := s.db.tiles[key]
+	rows := &fakeMBTilesRows{}
+	switch {
+	case strings.HasPrefix(s.query, "SELECT 1 FROM tiles"):
+		rows.column = "1"
+		if ok {
+			rows.values = []driver.Value{int64(1)}
+		}
+	case strings.HasPrefix(s.query, "SELECT tile_data FROM tiles"):
+		rows.column = "tile_data"
+		if ok {
+			rows.values = []driver.Value{data}
+		}
+	default:
+		return nil, fmt.Errorf("unexpected query %q", s.query)
+	}
+	return rows, nil
+}
+
+type fakeMBTilesRows struct {
+	column string
+	values []driver.Value
+}
+
+func (r *fakeMBTilesRows) Columns() []string { return []string{r.column} }
+func (r *fakeMBTilesRows) Close() error      { return nil }
+
+func (r *fakeMBTilesRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0] = r.values[0]
+	r.values = r.values[1:]
+	return nil
+}
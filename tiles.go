@@ -0,0 +1,276 @@
+package geoapify
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"math"
+	"sync"
+)
+
+// maxMercatorLat is the latitude limit of the Web Mercator projection.
+const maxMercatorLat = 85.05112878
+
+// TilesService provides access to the GeoApify raster map tiles.
+type TilesService struct {
+	client *Client
+}
+
+// Tile identifies a Web Mercator map tile.
+type Tile struct {
+	Z int
+	X int
+	Y int
+}
+
+// String returns the tile in z/x/y notation.
+func (t Tile) String() string {
+	return fmt.Sprintf("%d/%d/%d", t.Z, t.X, t.Y)
+}
+
+// TileAt returns the tile containing the location at the given zoom level.
+func TileAt(loc Location, zoom int) Tile {
+	n := math.Exp2(float64(zoom))
+	lat := toRadians(math.Max(-maxMercatorLat, math.Min(maxMercatorLat, loc.Lat)))
+	x := int(math.Floor((normalizeLon(loc.Lon) + 180) / 360 * n))
+	y := int(math.Floor((1 - math.Log(math.Tan(lat)+1/math.Cos(lat))/math.Pi) / 2 * n))
+	maxIndex := int(n) - 1
+	return Tile{Z: zoom, X: min(max(x, 0), maxIndex), Y: min(max(y, 0), maxIndex)}
+}
+
+// NorthWest returns the north-west corner of the tile.
+func (t Tile) NorthWest() Location {
+	n := math.Exp2(float64(t.Z))
+	lon := float64(t.X)/n*360 - 180
+	lat := toDegrees(math.Atan(math.Sinh(math.Pi * (1 - 2*float64(t.Y)/n))))
+	return LatLon(lat, lon)
+}
+
+// Bounds returns the area covered by the tile.
+func (t Tile) Bounds() BBox {
+	nw := t.NorthWest()
+	se := Tile{Z: t.Z, X: t.X + 1, Y: t.Y + 1}.NorthWest()
+	return BBox{MinLon: nw.Lon, MinLat: se.Lat, MaxLon: se.Lon, MaxLat: nw.Lat}
+}
+
+// TilesCovering returns the tiles covering the box at every zoom level from
+// minZoom to maxZoom, ordered by zoom, then column, then row.
+func TilesCovering(b BBox, minZoom, maxZoom int) iter.Seq[Tile] {
+	return func(yield func(Tile) bool) {
+		for z := minZoom; z <= maxZoom; z++ {
+			for _, r := range tileRanges(b, z) {
+				for x := r.minX; x <= r.maxX; x++ {
+					for y := r.minY; y <= r.maxY; y++ {
+						if !yield(Tile{Z: z, X: x, Y: y}) {
+							return
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// CountTiles returns the number of tiles TilesCovering yields.
+func CountTiles(b BBox, minZoom, maxZoom int) int {
+	total := 0
+	for z := minZoom; z <= maxZoom; z++ {
+		for _, r := range tileRanges(b, z) {
+			total += (r.maxX - r.minX + 1) * (r.maxY - r.minY + 1)
+		}
+	}
+	return total
+}
+
+type tileRange struct{ minX, maxX, minY, maxY int }
+
+// tileRanges splits boxes crossing the antimeridian into two ranges.
+func tileRanges(b BBox, z int) []tileRange {
+	nw := TileAt(LatLon(b.MaxLat, b.MinLon), z)
+	se := TileAt(LatLon(b.MinLat, b.MaxLon), z)
+	if b.MaxLon == 180 {
+		se.X = (1 << z) - 1
+	}
+	if b.MinLon <= b.MaxLon {
+		return []tileRange{{nw.X, se.X, nw.Y, se.Y}}
+	}
+	return []tileRange{
+		{nw.X, (1 << z) - 1, nw.Y, se.Y},
+		{0, se.X, nw.Y, se.Y},
+	}
+}
+
+// URL returns the URL of a tile, including the API key. Retina tiles are
+// rendered at twice the resolution.
+func (s *TilesService) URL(style MapStyle, t Tile, retina bool) string {
+	suffix := ""
+	if retina {
+		suffix = "@2x"
+	}
+	path := fmt.Sprintf("/v1/tile/%s/%d/%d/%d%s.png", style, t.Z, t.X, t.Y, suffix)
+	return s.client.buildURLWithBase(s.client.mapsBaseURL, path, nil)
+}
+
+// Fetch downloads a single tile.
+func (s *TilesService) Fetch(ctx context.Context, style MapStyle, t Tile, retina bool) ([]byte, error) {
+	return s.client.doGetBytes(ctx, s.URL(style, t, retina))
+}
+
+// Download creates a builder that downloads all tiles covering an area into a
+// store.
+func (s *TilesService) Download(style MapStyle, store TileStore) *TileDownloadRequest {
+	return &TileDownloadRequest{
+		service:     s,
+		style:       style,
+		store:       store,
+		concurrency: defaultTileConcurrency,
+	}
+}
+
+const defaultTileConcurrency = 4
+
+// TileDownloadRequest is a builder for bulk tile downloads.
+type TileDownloadRequest struct {
+	service     *TilesService
+	style       MapStyle
+	store       TileStore
+	bbox        BBox
+	hasArea     bool
+	minZoom     int
+	maxZoom     int
+	retina      bool
+	concurrency int
+	progress    func(TileDownloadStats)
+}
+
+// WithArea sets the area to download. It is required.
+func (r *TileDownloadRequest) WithArea(b BBox) *TileDownloadRequest {
+	r.bbox = b
+	r.hasArea = true
+	return r
+}
+
+// WithZoom sets the range of zoom levels to download.
+func (r *TileDownloadRequest) WithZoom(minZoom, maxZoom int) *TileDownloadRequest {
+	r.minZoom = minZoom
+	r.maxZoom = maxZoom
+	return r
+}
+
+// WithRetina downloads tiles at twice the resolution.
+func (r *TileDownloadRequest) WithRetina(v bool) *TileDownloadRequest {
+	r.retina = v
+	return r
+}
+
+// WithConcurrency sets the number of tiles downloaded in parallel.
+func (r *TileDownloadRequest) WithConcurrency(n int) *TileDownloadRequest {
+	r.concurrency = n
+	return r
+}
+
+// WithProgress sets a callback invoked after each tile is processed. It is
+// called from a single goroutine at a time.
+func (r *TileDownloadRequest) WithProgress(fn func(TileDownloadStats)) *TileDownloadRequest {
+	r.progress = fn
+	return r
+}
+
+// TileDownloadStats reports the progress of a tile download.
+type TileDownloadStats struct {
+	Total      int
+	Downloaded int
+	// Skipped counts tiles that were already in the store.
+	Skipped int
+}
+
+// Do downloads every missing tile. Tiles already in the store are skipped, so
+// an interrupted download resumes where it stopped when run again. The first
+// error cancels the remaining downloads.
+func (r *TileDownloadRequest) Do(ctx context.Context) (TileDownloadStats, error) {
+	stats := TileDownloadStats{Total: CountTiles(r.bbox, r.minZoom, r.maxZoom)}
+	if r.store == nil {
+		return stats, fmt.Errorf("%w: no tile store", ErrInvalidArgument)
+	}
+	if r.minZoom < 0 || r.maxZoom < r.minZoom {
+		return stats, fmt.Errorf("%w: invalid zoom range %d-%d", ErrInvalidArgument, r.minZoom, r.maxZoom)
+	}
+	if !r.hasArea {
+		return stats, fmt.Errorf("%w: no area to download", ErrInvalidArgument)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	fail := func(err error) {
+		mu.Lock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+		mu.Unlock()
+	}
+	report := func(downloaded bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		if downloaded {
+			stats.Downloaded++
+		} else {
+			stats.Skipped++
+		}
+		if r.progress != nil {
+			r.progress(stats)
+		}
+	}
+
+	tiles := make(chan Tile)
+	workers := max(r.concurrency, 1)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t := range tiles {
+				ok, err := r.store.HasTile(ctx, t)
+				if err != nil {
+					fail(fmt.Errorf("checking tile %s: %w", t, err))
+					continue
+				}
+				if ok {
+					report(false)
+					continue
+				}
+				data, err := r.service.Fetch(ctx, r.style, t, r.retina)
+				if err != nil {
+					fail(fmt.Errorf("fetching tile %s: %w", t, err))
+					continue
+				}
+				if err := r.store.PutTile(ctx, t, data); err != nil {
+					fail(fmt.Errorf("storing tile %s: %w", t, err))
+					continue
+				}
+				report(true)
+			}
+		}()
+	}
+
+	for t := range TilesCovering(r.bbox, r.minZoom, r.maxZoom) {
+		select {
+		case tiles <- t:
+			continue
+		case <-ctx.Done():
+		}
+		break
+	}
+	close(tiles)
+	wg.Wait()
+
+	if firstErr != nil {
+		return stats, firstErr
+	}
+	return stats, ctx.Err()
+}
@@ -0,0 +1,169 @@
+package geoapify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestTileAt(t *testing.T) {
+	tests := []struct {
+		name string
+		loc  Location
+		zoom int
+		want Tile
+	}{
+		{"origin zoom 0", LonLat(0, 0), 0, Tile{0, 0, 0}},
+		{"origin zoom 1", LonLat(0, 0), 1, Tile{1, 1, 1}},
+		{"berlin", LonLat(13.405, 52.52), 10, Tile{10, 550, 335}},
+		{"clamped pole", LonLat(-180, 90), 2, Tile{2, 0, 0}},
+		{"east edge", LonLat(179.9999, -85.1), 2, Tile{2, 3, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertEqual(t, TileAt(tt.loc, tt.zoom), tt.want)
+		})
+	}
+}
+
+func TestTile_Bounds(t *testing.T) {
+	b := Tile{Z: 1, X: 1, Y: 0}.Bounds()
+	assertNear(t, b.MinLon, 0, 1e-9)
+	assertNear(t, b.MaxLon, 180, 1e-9)
+	assertNear(t, b.MinLat, 0, 1e-9)
+	assertNear(t, b.MaxLat, maxMercatorLat, 1e-6)
+
+	tile := TileAt(LonLat(13.405, 52.52), 12)
+	assertEqual(t, tile.Bounds().Contains(LonLat(13.405, 52.52)), true)
+	assertEqual(t, tile.String(), "12/2200/1343")
+}
+
+func TestTilesCovering(t *testing.T) {
+	b := BBox{MinLon: -10, MinLat: -10, MaxLon: 10, MaxLat: 10}
+
+	var got []Tile
+	for tile := range TilesCovering(b, 0, 1) {
+		got = append(got, tile)
+	}
+	assertEqual(t, len(got), 5)
+	assertEqual(t, got[0], Tile{0, 0, 0})
+	assertEqual(t, got[1], Tile{1, 0, 0})
+	assertEqual(t, got[4], Tile{1, 1, 1})
+	assertEqual(t, CountTiles(b, 0, 1), 5)
+
+	antimeridian := BBox{MinLon: 170, MinLat: 1, MaxLon: -170, MaxLat: 10}
+	got = got[:0]
+	for tile := range TilesCovering(antimeridian, 2, 2) {
+		got = append(got, tile)
+	}
+	assertEqual(t, len(got), 2)
+	assertEqual(t, got[0], Tile{2, 3, 1})
+	assertEqual(t, got[1], Tile{2, 0, 1})
+	assertEqual(t, CountTiles(antimeridian, 2, 2), 2)
+
+	n := 0
+	for range TilesCovering(b, 0, 10) {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	assertEqual(t, n, 3)
+}
+
+func TestTiles_URL(t *testing.T) {
+	client := NewClient("test-api-key")
+
+	assertEqual(t, client.Tiles().URL(StyleOSMBright, Tile{3, 4, 2}, false),
+		"https://maps.geoapify.com/v1/tile/osm-bright/3/4/2.png?apiKey=test-api-key")
+	assertEqual(t, client.Tiles().URL(StyleDarkMatter, Tile{3, 4, 2}, true),
+		"https://maps.geoapify.com/v1/tile/dark-matter/3/4/2@2x.png?apiKey=test-api-key")
+}
+
+func TestTiles_Download(t *testing.T) {
+	var requests atomic.Int32
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if !strings.HasPrefix(r.URL.Path, "/v1/tile/osm-carto/") {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Write([]byte(r.URL.Path))
+	})
+
+	store := NewDirTileStore(t.TempDir())
+	ctx := context.Background()
+	assertNoError(t, store.PutTile(ctx, Tile{1, 0, 0}, []byte("cached")))
+
+	var calls int
+	stats, err := client.Tiles().Download(StyleOSMCarto, store).
+		WithArea(BBox{MinLon: -10, MinLat: -10, MaxLon: 10, MaxLat: 10}).
+		WithZoom(0, 1).
+		WithConcurrency(3).
+		WithProgress(func(TileDownloadStats) { calls++ }).
+		Do(ctx)
+	assertNoError(t, err)
+	assertEqual(t, stats, TileDownloadStats{Total: 5, Downloaded: 4, Skipped: 1})
+	assertEqual(t, calls, 5)
+	assertEqual(t, requests.Load(), int32(4))
+
+	data, err := store.GetTile(ctx, Tile{1, 1, 1})
+	assertNoError(t, err)
+	assertEqual(t, string(data), "/v1/tile/osm-carto/1/1/1.png")
+
+	stats, err = client.Tiles().Download(StyleOSMCarto, store).
+		WithArea(BBox{MinLon: -10, MinLat: -10, MaxLon: 10, MaxLat: 10}).
+		WithZoom(0, 1).
+		Do(ctx)
+	assertNoError(t, err)
+	assertEqual(t, stats.Skipped, 5)
+	assertEqual(t, requests.Load(), int32(4))
+}
+
+func TestTiles_DownloadError(t *testing.T) {
+	_, client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/1/1/1.png") {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"message":"Rate limit exceeded"}`))
+			return
+		}
+		w.Write([]byte("tile"))
+	})
+
+	store := NewDirTileStore(t.TempDir())
+	_, err := client.Tiles().Download(StyleOSMCarto, store).
+		WithArea(BBox{MinLon: -10, MinLat: -10, MaxLon: 10, MaxLat: 10}).
+		WithZoom(0, 1).
+		WithConcurrency(1).
+		Do(context.Background())
+	assertError(t, err)
+
+	apiErr, ok := IsAPIError(err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	assertEqual(t, apiErr.StatusCode, 429)
+
+	ok, err = store.HasTile(context.Background(), Tile{1, 1, 1})
+	assertNoError(t, err)
+	assertEqual(t, ok, false)
+}
+
+func TestTiles_DownloadValidation(t *testing.T) {
+	client := NewClient("test-api-key")
+
+	_, err := client.Tiles().Download(StyleOSMCarto, nil).WithZoom(0, 1).Do(context.Background())
+	assertError(t, err)
+
+	_, err = client.Tiles().Download(StyleOSMCarto, NewDirTileStore(t.TempDir())).WithZoom(3, 1).Do(context.Background())
+	assertError(t, err)
+	assertEqual(t, strings.Contains(fmt.Sprint(err), "zoom"), true)
+
+	_, err = client.Tiles().Download(StyleOSMCarto, NewDirTileStore(t.TempDir())).WithZoom(0, 1).Do(context.Background())
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument without an area, got %v", err)
+	}
+}